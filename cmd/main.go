@@ -1,23 +1,206 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
 	"github.com/xich-dev/go-starter/pkg/logger"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/service"
 	"github.com/xich-dev/go-starter/wire"
 	"go.uber.org/zap"
 )
 
 var log = logger.NewLogAgent("main")
 
+const usage = `usage: %s <command> [args]
+
+commands:
+  serve [-mode m]      start the apps, the default command, m is server for the API
+                       server, worker for the background work or all for both,
+                       defaults to all
+  migrate up           apply all pending migrations
+  migrate down [n]     roll back the last n migrations, defaults to 1
+  migrate goto <v>     migrate up or down to version v
+  migrate status       print the current and the latest version
+  migrate force <v>    record version v without running migrations, to clear the dirty state
+  seed [env]           load the fixtures of env, defaults to seed.env in the config
+`
+
 func main() {
-	s, err := wire.InitializeServer()
-	if err != nil {
-		log.Error("failed to initialize server", zap.Error(err))
-		panic(err)
+	args := os.Args[1:]
+	command := "serve"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
 	}
 
-	if err := s.Listen(); err != nil {
+	var err error
+	switch command {
+	case "serve":
+		err = serve(args)
+	case "migrate":
+		err = runMigrate(args)
+	case "seed":
+		err = runSeed(args)
+	default:
+		fmt.Fprintf(os.Stderr, usage, os.Args[0])
+		os.Exit(2)
+	}
+	if err != nil {
 		log.Error("exit with error", zap.Error(err))
+		os.Exit(1)
+	}
+}
+
+// app is the server, the worker or both of them.
+type app interface {
+	// Listen blocks until the app fails or is shut down.
+	Listen() error
+	Shutdown() error
+}
+
+func serve(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	mode := flags.String("mode", "all", "the app to run: server, worker or all")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var (
+		a       app
+		cleanup func()
+		err     error
+	)
+	switch *mode {
+	case "server":
+		a, cleanup, err = wire.InitializeServer()
+	case "worker":
+		a, cleanup, err = wire.InitializeWorker()
+	case "all":
+		a, cleanup, err = wire.InitializeAll()
+	default:
+		return errors.Errorf("unknown mode %s, expected server, worker or all", *mode)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s", *mode)
+	}
+	// the database connections are closed last, after the app has stopped using them
+	defer cleanup()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- a.Listen()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	// a second signal kills the process right away
+	stop()
+	log.Info("shutting down")
+
+	if err := a.Shutdown(); err != nil {
+		log.Error("failed to shut down gracefully", zap.Error(err))
+	}
+	if err := <-errc; err != nil {
+		return err
 	}
 
 	log.Info("bye.")
+	return nil
+}
+
+func parseVersion(args []string) (int, error) {
+	if len(args) != 1 {
+		return 0, errors.New("version is required")
+	}
+	version, err := strconv.Atoi(args[0])
+	if err != nil || version < 0 {
+		return 0, errors.Errorf("invalid version %s", args[0])
+	}
+	return version, nil
+}
+
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintf(os.Stderr, usage, os.Args[0])
+		os.Exit(2)
+	}
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+	migrator, err := model.NewMigrator(cfg)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				return errors.Errorf("invalid steps %s", args[1])
+			}
+		}
+		return migrator.Down(ctx, steps)
+	case "goto":
+		version, err := parseVersion(args[1:])
+		if err != nil {
+			return err
+		}
+		return migrator.Goto(ctx, uint(version))
+	case "force":
+		version, err := parseVersion(args[1:])
+		if err != nil {
+			return err
+		}
+		return migrator.Force(ctx, version)
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d\nlatest: %d\ndirty: %t\n", status.Version, status.Latest, status.Dirty)
+		return nil
+	default:
+		return errors.Errorf("unknown migrate command %s", args[0])
+	}
+}
+
+func runSeed(args []string) error {
+	var env string
+	if len(args) > 0 {
+		env = args[0]
+	}
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+	fixtures, err := service.LoadSeedFixtures(&cfg.Seed, env)
+	if err != nil {
+		return err
+	}
+	svc, cleanup, err := wire.InitializeService()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize service")
+	}
+	defer cleanup()
+	return svc.Seed(context.Background(), fixtures)
 }
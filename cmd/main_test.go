@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		args     []string
+		expected int
+		isErr    bool
+	}{
+		{args: []string{"0"}, expected: 0},
+		{args: []string{"16"}, expected: 16},
+		{args: nil, isErr: true},
+		{args: []string{"1", "2"}, isErr: true},
+		{args: []string{"-1"}, isErr: true},
+		{args: []string{"v1"}, isErr: true},
+	}
+	for _, testCase := range testCases {
+		version, err := parseVersion(testCase.args)
+		if testCase.isErr {
+			assert.Error(t, err, testCase.args)
+			continue
+		}
+		require.NoError(t, err, testCase.args)
+		assert.Equal(t, testCase.expected, version)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build !ut
+// +build !ut
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/jobs"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+type staleJobArgs struct{}
+
+func (staleJobArgs) Kind() string {
+	return "e2e.stale"
+}
+
+// TestStaleJobWorker checks a worker finishing a job after it has been rescued and
+// claimed by another worker does not finalize it.
+func TestStaleJobWorker(t *testing.T) {
+	cfg, err := config.NewConfig()
+	require.NoError(t, err)
+	m, cleanup, err := model.NewModel(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+
+	ctx := model.WithBypassRLS(context.Background())
+	// the job is due before any other, so it is the one claimed
+	id, err := jobs.Enqueue(ctx, m, staleJobArgs{}, jobs.RunAt(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+
+	now := time.Now()
+	stale, err := m.ClaimNextJob(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, id, stale.ID)
+
+	// the job runs past the timeout, it is rescued and claimed again
+	_, err = m.RescueStuckJobs(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	current, err := m.ClaimNextJob(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, id, current.ID)
+
+	updated, err := m.CompleteJob(ctx, querier.CompleteJobParams{ID: id, ClaimedAt: *stale.LockedAt})
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+	updated, err = m.RetryJob(ctx, querier.RetryJobParams{
+		ID:        id,
+		LastError: utils.Ptr("timeout"),
+		RunAt:     now,
+		ClaimedAt: *stale.LockedAt,
+	})
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+	updated, err = m.FailJob(ctx, querier.FailJobParams{
+		ID:        id,
+		LastError: utils.Ptr("timeout"),
+		ClaimedAt: *stale.LockedAt,
+	})
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+
+	// the job is still held by the current worker
+	updated, err = m.CompleteJob(ctx, querier.CompleteJobParams{ID: id, ClaimedAt: *current.LockedAt})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+}
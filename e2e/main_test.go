@@ -43,13 +43,15 @@ var (
 )
 
 func TestMain(m *testing.M) {
-	server, err := wire.InitializeServer()
+	server, cleanup, err := wire.InitializeServer()
 	if err != nil {
 		log.Fatal(err)
 	}
 	apiServer = server
 
-	os.Exit(m.Run())
+	code := m.Run()
+	cleanup()
+	os.Exit(code)
 }
 
 func getTestEngine(t *testing.T) *httpexpect.Expect {
@@ -0,0 +1,61 @@
+//go:build !ut
+// +build !ut
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+// TestRevokeTeamPlatformRules checks the migration revoking the platform rules granted
+// to teams sees the grants of every org, although the app role is subject to RLS.
+func TestRevokeTeamPlatformRules(t *testing.T) {
+	ate := getAuthenticatedTestEngine(t)
+	orgID := ate.authInfo.OrgID
+
+	cfg, err := config.NewConfig()
+	require.NoError(t, err)
+	m, cleanup, err := model.NewModel(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+	migrator, err := model.NewMigrator(cfg)
+	require.NoError(t, err)
+	defer migrator.Close()
+
+	ctx := context.Background()
+	require.NoError(t, migrator.Goto(ctx, 15))
+	defer func() {
+		require.NoError(t, migrator.Up(ctx))
+	}()
+
+	orgCtx := model.WithOrg(ctx, orgID)
+	team, err := m.CreateTeam(orgCtx, querier.CreateTeamParams{
+		OrgID: orgID,
+		Name:  "platform-" + uuid.Must(uuid.NewRandom()).String()[:8],
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, err := m.DeleteTeam(orgCtx, querier.DeleteTeamParams{ID: team.ID, OrgID: orgID})
+		require.NoError(t, err)
+	}()
+	rule, err := m.GetAccessRule(ctx, "worker")
+	require.NoError(t, err)
+	require.NoError(t, m.AddTeamAccessRule(orgCtx, querier.AddTeamAccessRuleParams{
+		TeamID: team.ID,
+		RuleID: rule.ID,
+	}))
+
+	require.NoError(t, migrator.Up(ctx))
+
+	names, err := m.GetTeamAccessRuleNames(orgCtx, team.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, names, "worker")
+}
@@ -0,0 +1,76 @@
+//go:build !ut
+// +build !ut
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
+)
+
+func getBalance(t *testing.T, ate *AutenticatedTestEngine) int64 {
+	t.Helper()
+
+	var orgInfo apigen.OrgInfoRes
+	ate.GET("/api/v1/orgs").
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&orgInfo)
+	return orgInfo.Balance
+}
+
+func TestRecharge(t *testing.T) {
+	ate := getAuthenticatedTestEngine(t)
+	orgID := ate.authInfo.OrgID
+	balance := getBalance(t, ate)
+
+	var order apigen.RechargeOrder
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/recharges", orgID)).
+		WithJSON(apigen.PostOrgsIdRechargesJSONBody{
+			Amount: 100,
+		}).
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&order)
+	assert.Equal(t, "pending", order.Status)
+	assert.NotNil(t, order.CodeUrl)
+
+	body, err := json.Marshal(payment.FakeNotification{
+		OrderID: order.Id.String(),
+		TradeID: "fake-trade",
+		Paid:    true,
+		Amount:  order.Amount,
+	})
+	require.NoError(t, err)
+
+	ate.POST(fmt.Sprintf("/api/v1/payments/%s/notify", payment.ProviderFake)).
+		WithBytes(body).
+		WithHeader(payment.FakeSignatureHeader, "invalid").
+		Expect().
+		Status(400)
+
+	// notifications are retried by the provider, the order must only be credited once
+	for i := 0; i < 2; i++ {
+		ate.POST(fmt.Sprintf("/api/v1/payments/%s/notify", payment.ProviderFake)).
+			WithBytes(body).
+			WithHeader(payment.FakeSignatureHeader, payment.FakeSign(body)).
+			Expect().
+			Status(200)
+	}
+
+	ate.GET(fmt.Sprintf("/api/v1/orgs/%s/recharges/%s", orgID, order.Id)).
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&order)
+	assert.Equal(t, "paid", order.Status)
+	assert.Equal(t, balance+100, getBalance(t, ate))
+}
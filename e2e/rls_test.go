@@ -0,0 +1,94 @@
+//go:build !ut
+// +build !ut
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model"
+)
+
+// TestRowLevelSecurity checks the org tables are isolated by the database itself, it
+// fails if the app connects as a superuser or a role with BYPASSRLS, which are never
+// subject to the policies.
+func TestRowLevelSecurity(t *testing.T) {
+	ate := getAuthenticatedTestEngine(t)
+	orgID := ate.authInfo.OrgID
+
+	var team apigen.Team
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/teams", orgID)).
+		WithJSON(apigen.PostOrgsIdTeamsJSONBody{
+			Name: "rls",
+		}).
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&team)
+	defer func() {
+		ate.DELETE(fmt.Sprintf("/api/v1/orgs/%s/teams/%s", orgID, team.Id)).
+			Expect().
+			Status(200)
+	}()
+
+	cfg, err := config.NewConfig()
+	require.NoError(t, err)
+	m, cleanup, err := model.NewModel(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+
+	hasTeam := func(ctx context.Context) bool {
+		teams, err := m.ListOrgTeams(ctx, orgID)
+		require.NoError(t, err)
+		for _, tm := range teams {
+			if tm.ID == team.Id {
+				return true
+			}
+		}
+		return false
+	}
+
+	ctx := context.Background()
+	assert.True(t, hasTeam(model.WithOrg(ctx, orgID)), "the org sees its own rows")
+	assert.False(t, hasTeam(model.WithOrg(ctx, uuid.Must(uuid.NewRandom()))), "another org sees the rows, is the app connecting as a superuser?")
+	assert.False(t, hasTeam(ctx), "a query without org sees the rows, is the app connecting as a superuser?")
+	assert.True(t, hasTeam(model.WithBypassRLS(ctx)), "the background work sees all rows")
+}
+
+func TestUsersRowLevelSecurity(t *testing.T) {
+	ate := getAuthenticatedTestEngine(t)
+	orgID := ate.authInfo.OrgID
+	userID := *ate.authInfo.Id
+
+	cfg, err := config.NewConfig()
+	require.NoError(t, err)
+	m, cleanup, err := model.NewModel(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+
+	visible := func(ctx context.Context) bool {
+		_, userErr := m.GetUserByID(ctx, userID)
+		_, orgErr := m.GetOrgInfoByOrgId(ctx, orgID)
+		for _, err := range []error{userErr, orgErr} {
+			if err != nil {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+			}
+		}
+		assert.Equal(t, userErr == nil, orgErr == nil, "the user and its org are visible together")
+		return userErr == nil
+	}
+
+	ctx := context.Background()
+	assert.True(t, visible(model.WithOrg(ctx, orgID)), "the org sees its own users")
+	assert.False(t, visible(model.WithOrg(ctx, uuid.Must(uuid.NewRandom()))), "another org sees the users")
+	assert.False(t, visible(ctx), "a query without org sees the users")
+	assert.True(t, visible(model.WithBypassRLS(ctx)), "the sign in sees all users")
+}
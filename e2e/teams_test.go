@@ -0,0 +1,63 @@
+//go:build !ut
+// +build !ut
+
+package e2e
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model"
+)
+
+func TestTeams(t *testing.T) {
+	ate := getAuthenticatedTestEngine(t)
+	orgID := ate.authInfo.OrgID
+
+	var team apigen.Team
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/teams", orgID)).
+		WithJSON(apigen.PostOrgsIdTeamsJSONBody{
+			Name: "dev",
+		}).
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&team)
+	assert.Equal(t, orgID, team.OrgId)
+
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/teams", orgID)).
+		WithJSON(apigen.PostOrgsIdTeamsJSONBody{
+			Name: "dev",
+		}).
+		Expect().
+		Status(400)
+
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/teams/%s/members", orgID, team.Id)).
+		WithJSON(apigen.PostOrgsIdTeamsTeamIdMembersJSONBody{
+			UserId: *ate.authInfo.Id,
+		}).
+		Expect().
+		Status(200)
+
+	ate.POST(fmt.Sprintf("/api/v1/orgs/%s/teams/%s/rules", orgID, team.Id)).
+		WithJSON(apigen.PostOrgsIdTeamsTeamIdRulesJSONBody{
+			Rule: model.RuleWorker,
+		}).
+		Expect().
+		Status(403)
+
+	var detail apigen.TeamDetail
+	ate.GET(fmt.Sprintf("/api/v1/orgs/%s/teams/%s", orgID, team.Id)).
+		Expect().
+		Status(200).
+		JSON().
+		Decode(&detail)
+	assert.Empty(t, detail.Rules)
+	assert.Len(t, detail.Members, 1)
+
+	ate.DELETE(fmt.Sprintf("/api/v1/orgs/%s/teams/%s", orgID, team.Id)).
+		Expect().
+		Status(200)
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -39,8 +40,19 @@ type AuthInfo struct {
 	Username     string              `json:"username"`
 }
 
+// DingTalkCallbackResponse 钉钉事件回调的加密响应
+type DingTalkCallbackResponse struct {
+	Encrypt      string `json:"encrypt"`
+	MsgSignature string `json:"msg_signature"`
+	Nonce        string `json:"nonce"`
+	TimeStamp    string `json:"timeStamp"`
+}
+
 // OrgInfoRes 组织信息
 type OrgInfoRes struct {
+	// Balance 余额，单位为分
+	Balance int64 `json:"balance"`
+
 	// Id 组织ID
 	Id openapi_types.UUID `json:"id"`
 
@@ -51,6 +63,263 @@ type OrgInfoRes struct {
 	OwnerId *openapi_types.UUID `json:"ownerId,omitempty"`
 }
 
+// OrgMember 组织成员
+type OrgMember struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id 用户ID
+	Id       openapi_types.UUID `json:"id"`
+	Phone    string             `json:"phone"`
+	Username string             `json:"username"`
+}
+
+// OrgMemberPage 组织成员的一页，分页接口的返回均为 items 加 nextCursor
+type OrgMemberPage struct {
+	Items []OrgMember `json:"items"`
+
+	// NextCursor 下一页的游标，没有下一页时为空
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// OrgUsage 组织当前计费周期的用量
+type OrgUsage struct {
+	Items       []UsageItem `json:"items"`
+	PeriodEnd   time.Time   `json:"periodEnd"`
+	PeriodStart time.Time   `json:"periodStart"`
+}
+
+// Plan 套餐
+type Plan struct {
+	// Name 套餐名称
+	Name string `json:"name"`
+
+	// Price 每个周期的价格，单位为分
+	Price int64 `json:"price"`
+
+	// PriceText 格式化后的价格
+	PriceText string `json:"priceText"`
+
+	// Quotas 每个周期包含的额度
+	Quotas []PlanQuota `json:"quotas"`
+
+	// Rules 套餐解锁的访问规则
+	Rules []string `json:"rules"`
+}
+
+// PlanQuota 套餐包含的计费项额度
+type PlanQuota struct {
+	// Limit 额度，0 表示不限
+	Limit int64 `json:"limit"`
+
+	// Metric 计费项，sms 或 api_call
+	Metric string `json:"metric"`
+}
+
+// RechargeOrder 充值订单
+type RechargeOrder struct {
+	// Amount 充值金额，单位为分
+	Amount int64 `json:"amount"`
+
+	// CodeUrl 支付二维码链接，仅在创建订单时返回
+	CodeUrl   *string            `json:"codeUrl,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+	ExpiredAt time.Time          `json:"expiredAt"`
+	Id        openapi_types.UUID `json:"id"`
+	OrgId     openapi_types.UUID `json:"orgId"`
+	PaidAt    *time.Time         `json:"paidAt,omitempty"`
+
+	// Provider 支付渠道
+	Provider string `json:"provider"`
+
+	// Status 订单状态，pending, paid, failed 或 expired
+	Status string `json:"status"`
+}
+
+// ScheduledTaskRun 定时任务的一次运行
+type ScheduledTaskRun struct {
+	// Error 失败的原因
+	Error      *string            `json:"error,omitempty"`
+	FinishedAt time.Time          `json:"finishedAt"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// ScheduledAt 计划运行时间
+	ScheduledAt time.Time `json:"scheduledAt"`
+	StartedAt   time.Time `json:"startedAt"`
+
+	// Status 运行结果，succeeded 或 failed
+	Status string `json:"status"`
+	Task   string `json:"task"`
+}
+
+// ScheduledTaskRunPage 定时任务运行记录的一页
+type ScheduledTaskRunPage struct {
+	Items []ScheduledTaskRun `json:"items"`
+
+	// NextCursor 下一页的游标，没有下一页时为空
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// Statement 月度对账单
+type Statement struct {
+	// ClosingBalance 期末余额，单位为分
+	ClosingBalance int64              `json:"closingBalance"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	Id             openapi_types.UUID `json:"id"`
+
+	// InvoiceNo 发票号
+	InvoiceNo string `json:"invoiceNo"`
+
+	// Month 对账月份，格式为 2006-01
+	Month string `json:"month"`
+
+	// OpeningBalance 期初余额，单位为分
+	OpeningBalance int64              `json:"openingBalance"`
+	OrgId          openapi_types.UUID `json:"orgId"`
+}
+
+// StatementDetail 对账单详情
+type StatementDetail struct {
+	Items     []StatementItem `json:"items"`
+	Statement Statement       `json:"statement"`
+}
+
+// StatementItem 对账单中按交易类型汇总的金额
+type StatementItem struct {
+	// Amount 对余额的影响，入账为正，扣款为负，单位为分
+	Amount int64 `json:"amount"`
+
+	// Count 交易笔数
+	Count int64 `json:"count"`
+
+	// Typ 交易类型
+	Typ string `json:"typ"`
+}
+
+// Subscription 组织的套餐订阅
+type Subscription struct {
+	// CancelAtPeriodEnd 当前周期结束后是否降级为免费套餐
+	CancelAtPeriodEnd  bool      `json:"cancelAtPeriodEnd"`
+	CurrentPeriodEnd   time.Time `json:"currentPeriodEnd"`
+	CurrentPeriodStart time.Time `json:"currentPeriodStart"`
+
+	// Plan 套餐名称
+	Plan string `json:"plan"`
+
+	// Version 版本号，未订阅时为0
+	Version int32 `json:"version"`
+}
+
+// Team 团队信息
+type Team struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id 团队ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name 团队名称
+	Name string `json:"name"`
+
+	// OrgId 所属组织ID
+	OrgId openapi_types.UUID `json:"orgId"`
+
+	// Version 版本号，每次修改后递增
+	Version int32 `json:"version"`
+}
+
+// TeamDetail 团队详情
+type TeamDetail struct {
+	Members []TeamMember `json:"members"`
+
+	// Rules 团队被授予的访问规则
+	Rules []string `json:"rules"`
+	Team  Team     `json:"team"`
+}
+
+// TeamMember 团队成员
+type TeamMember struct {
+	// Id 用户ID
+	Id       openapi_types.UUID `json:"id"`
+	Phone    string             `json:"phone"`
+	Username string             `json:"username"`
+}
+
+// UsageItem 计费项的用量
+type UsageItem struct {
+	// Limit 额度，0 表示不限
+	Limit int64 `json:"limit"`
+
+	// Metric 计费项，sms 或 api_call
+	Metric string `json:"metric"`
+
+	// Used 已用量
+	Used int64 `json:"used"`
+}
+
+// Webhook Webhook
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Enabled   bool      `json:"enabled"`
+
+	// Events 订阅的事件
+	Events []string           `json:"events"`
+	Id     openapi_types.UUID `json:"id"`
+	OrgId  openapi_types.UUID `json:"orgId"`
+
+	// Secret 签名密钥，仅在创建时返回
+	Secret *string `json:"secret,omitempty"`
+
+	// Url 接收事件的地址
+	Url string `json:"url"`
+}
+
+// WebhookDelivery Webhook 投递记录
+type WebhookDelivery struct {
+	// Attempts 已尝试次数
+	Attempts    int32      `json:"attempts"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+	Event       string     `json:"event"`
+
+	// Id 投递ID，与请求头 X-Webhook-Id 一致，可用于去重
+	Id openapi_types.UUID `json:"id"`
+
+	// LastError 最近一次投递的错误
+	LastError *string `json:"lastError,omitempty"`
+
+	// Payload 事件数据
+	Payload map[string]interface{} `json:"payload"`
+
+	// ResponseCode 最近一次投递的响应状态码
+	ResponseCode *int32 `json:"responseCode,omitempty"`
+
+	// Status 投递状态，pending, succeeded 或 failed
+	Status    string             `json:"status"`
+	WebhookId openapi_types.UUID `json:"webhookId"`
+}
+
+// WebhookDeliveryPage Webhook 投递记录的一页
+type WebhookDeliveryPage struct {
+	Items []WebhookDelivery `json:"items"`
+
+	// NextCursor 下一页的游标，没有下一页时为空
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// GetAdminScheduledTaskRunsParams defines parameters for GetAdminScheduledTaskRuns.
+type GetAdminScheduledTaskRunsParams struct {
+	// Task 只返回该任务的运行记录
+	Task *string `form:"task,omitempty" json:"task,omitempty"`
+
+	// Limit 每页数量，默认20，最大100
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 上一页返回的 nextCursor，为空时从第一页开始
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 排序字段，前缀 - 表示倒序，如 -createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
 // PostAuthChangePasswordJSONBody defines parameters for PostAuthChangePassword.
 type PostAuthChangePasswordJSONBody struct {
 	Code        string `json:"code"`
@@ -67,6 +336,11 @@ type PostAuthCodeJSONBody struct {
 // PostAuthCodeJSONBodyTyp defines parameters for PostAuthCode.
 type PostAuthCodeJSONBodyTyp string
 
+// PostAuthDeleteAccountJSONBody defines parameters for PostAuthDeleteAccount.
+type PostAuthDeleteAccountJSONBody struct {
+	Password string `json:"password"`
+}
+
 // PostAuthLoginJSONBody defines parameters for PostAuthLogin.
 type PostAuthLoginJSONBody struct {
 	Password        string `json:"password"`
@@ -86,12 +360,124 @@ type PostAuthRegisterJSONBody struct {
 	Username string `json:"username"`
 }
 
+// PostDingtalkCallbackParams defines parameters for PostDingtalkCallback.
+type PostDingtalkCallbackParams struct {
+	Signature string `form:"signature" json:"signature"`
+	Timestamp string `form:"timestamp" json:"timestamp"`
+	Nonce     string `form:"nonce" json:"nonce"`
+}
+
+// GetOrgsIdMembersParams defines parameters for GetOrgsIdMembers.
+type GetOrgsIdMembersParams struct {
+	// Limit 每页数量，默认20，最大100
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 上一页返回的 nextCursor，为空时从第一页开始
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 排序字段，前缀 - 表示倒序，如 -createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Keyword 按用户名或手机号过滤
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+}
+
+// PostOrgsIdRechargesJSONBody defines parameters for PostOrgsIdRecharges.
+type PostOrgsIdRechargesJSONBody struct {
+	// Amount 充值金额，单位为分
+	Amount int64 `json:"amount"`
+}
+
+// GetOrgsIdStatementsMonthParams defines parameters for GetOrgsIdStatementsMonth.
+type GetOrgsIdStatementsMonthParams struct {
+	// Format 返回格式，json, csv 或 pdf，默认为 json
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DeleteOrgsIdSubscriptionParams defines parameters for DeleteOrgsIdSubscription.
+type DeleteOrgsIdSubscriptionParams struct {
+	// IfMatch 读取时的版本号（ETag），与当前版本不一致时返回409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostOrgsIdSubscriptionParams defines parameters for PostOrgsIdSubscription.
+type PostOrgsIdSubscriptionParams struct {
+	// IfMatch 读取时的版本号（ETag），与当前版本不一致时返回409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostOrgsIdSubscriptionJSONBody defines parameters for PostOrgsIdSubscription.
+type PostOrgsIdSubscriptionJSONBody struct {
+	Plan string `json:"plan"`
+}
+
+// PostOrgsIdTeamsJSONBody defines parameters for PostOrgsIdTeams.
+type PostOrgsIdTeamsJSONBody struct {
+	Name string `json:"name"`
+}
+
+// DeleteOrgsIdTeamsTeamIdParams defines parameters for DeleteOrgsIdTeamsTeamId.
+type DeleteOrgsIdTeamsTeamIdParams struct {
+	// IfMatch 读取时的版本号（ETag），与当前版本不一致时返回409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutOrgsIdTeamsTeamIdParams defines parameters for PutOrgsIdTeamsTeamId.
+type PutOrgsIdTeamsTeamIdParams struct {
+	// IfMatch 读取时的版本号（ETag），与当前版本不一致时返回409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutOrgsIdTeamsTeamIdJSONBody defines parameters for PutOrgsIdTeamsTeamId.
+type PutOrgsIdTeamsTeamIdJSONBody struct {
+	Name string `json:"name"`
+}
+
+// PostOrgsIdTeamsTeamIdMembersJSONBody defines parameters for PostOrgsIdTeamsTeamIdMembers.
+type PostOrgsIdTeamsTeamIdMembersJSONBody struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// PostOrgsIdTeamsTeamIdRulesJSONBody defines parameters for PostOrgsIdTeamsTeamIdRules.
+type PostOrgsIdTeamsTeamIdRulesJSONBody struct {
+	Rule string `json:"rule"`
+}
+
+// PostOrgsIdWebhooksJSONBody defines parameters for PostOrgsIdWebhooks.
+type PostOrgsIdWebhooksJSONBody struct {
+	// Events 订阅的事件，可选 member.joined, balance.changed
+	Events []string `json:"events"`
+	Url    string   `json:"url"`
+}
+
+// PutOrgsIdWebhooksWebhookIdJSONBody defines parameters for PutOrgsIdWebhooksWebhookId.
+type PutOrgsIdWebhooksWebhookIdJSONBody struct {
+	Enabled bool     `json:"enabled"`
+	Events  []string `json:"events"`
+	Url     string   `json:"url"`
+}
+
+// GetOrgsIdWebhooksWebhookIdDeliveriesParams defines parameters for GetOrgsIdWebhooksWebhookIdDeliveries.
+type GetOrgsIdWebhooksWebhookIdDeliveriesParams struct {
+	// Limit 每页数量，默认20，最大100
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 上一页返回的 nextCursor，为空时从第一页开始
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 排序字段，前缀 - 表示倒序，如 -createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
 // PostAuthChangePasswordJSONRequestBody defines body for PostAuthChangePassword for application/json ContentType.
 type PostAuthChangePasswordJSONRequestBody PostAuthChangePasswordJSONBody
 
 // PostAuthCodeJSONRequestBody defines body for PostAuthCode for application/json ContentType.
 type PostAuthCodeJSONRequestBody PostAuthCodeJSONBody
 
+// PostAuthDeleteAccountJSONRequestBody defines body for PostAuthDeleteAccount for application/json ContentType.
+type PostAuthDeleteAccountJSONRequestBody PostAuthDeleteAccountJSONBody
+
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody PostAuthLoginJSONBody
 
@@ -101,6 +487,30 @@ type PostAuthRefreshTokenJSONRequestBody PostAuthRefreshTokenJSONBody
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody PostAuthRegisterJSONBody
 
+// PostOrgsIdRechargesJSONRequestBody defines body for PostOrgsIdRecharges for application/json ContentType.
+type PostOrgsIdRechargesJSONRequestBody PostOrgsIdRechargesJSONBody
+
+// PostOrgsIdSubscriptionJSONRequestBody defines body for PostOrgsIdSubscription for application/json ContentType.
+type PostOrgsIdSubscriptionJSONRequestBody PostOrgsIdSubscriptionJSONBody
+
+// PostOrgsIdTeamsJSONRequestBody defines body for PostOrgsIdTeams for application/json ContentType.
+type PostOrgsIdTeamsJSONRequestBody PostOrgsIdTeamsJSONBody
+
+// PutOrgsIdTeamsTeamIdJSONRequestBody defines body for PutOrgsIdTeamsTeamId for application/json ContentType.
+type PutOrgsIdTeamsTeamIdJSONRequestBody PutOrgsIdTeamsTeamIdJSONBody
+
+// PostOrgsIdTeamsTeamIdMembersJSONRequestBody defines body for PostOrgsIdTeamsTeamIdMembers for application/json ContentType.
+type PostOrgsIdTeamsTeamIdMembersJSONRequestBody PostOrgsIdTeamsTeamIdMembersJSONBody
+
+// PostOrgsIdTeamsTeamIdRulesJSONRequestBody defines body for PostOrgsIdTeamsTeamIdRules for application/json ContentType.
+type PostOrgsIdTeamsTeamIdRulesJSONRequestBody PostOrgsIdTeamsTeamIdRulesJSONBody
+
+// PostOrgsIdWebhooksJSONRequestBody defines body for PostOrgsIdWebhooks for application/json ContentType.
+type PostOrgsIdWebhooksJSONRequestBody PostOrgsIdWebhooksJSONBody
+
+// PutOrgsIdWebhooksWebhookIdJSONRequestBody defines body for PutOrgsIdWebhooksWebhookId for application/json ContentType.
+type PutOrgsIdWebhooksWebhookIdJSONRequestBody PutOrgsIdWebhooksWebhookIdJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -174,6 +584,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAdminScheduledTaskRuns request
+	GetAdminScheduledTaskRuns(ctx context.Context, params *GetAdminScheduledTaskRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthChangePasswordWithBody request with any body
 	PostAuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -184,6 +597,11 @@ type ClientInterface interface {
 
 	PostAuthCode(ctx context.Context, body PostAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostAuthDeleteAccountWithBody request with any body
+	PostAuthDeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthDeleteAccount(ctx context.Context, body PostAuthDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthLoginWithBody request with any body
 	PostAuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -205,8 +623,120 @@ type ClientInterface interface {
 
 	PostAuthRegister(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostDingtalkCallback request
+	PostDingtalkCallback(ctx context.Context, params *PostDingtalkCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOrgs request
 	GetOrgs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdMembers request
+	GetOrgsIdMembers(ctx context.Context, id openapi_types.UUID, params *GetOrgsIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdRechargesWithBody request with any body
+	PostOrgsIdRechargesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdRecharges(ctx context.Context, id openapi_types.UUID, body PostOrgsIdRechargesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdRechargesOrderId request
+	GetOrgsIdRechargesOrderId(ctx context.Context, id openapi_types.UUID, orderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdStatements request
+	GetOrgsIdStatements(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdStatementsMonth request
+	GetOrgsIdStatementsMonth(ctx context.Context, id openapi_types.UUID, month string, params *GetOrgsIdStatementsMonthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrgsIdSubscription request
+	DeleteOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, params *DeleteOrgsIdSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdSubscription request
+	GetOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdSubscriptionWithBody request with any body
+	PostOrgsIdSubscriptionWithBody(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, body PostOrgsIdSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdTeams request
+	GetOrgsIdTeams(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdTeamsWithBody request with any body
+	PostOrgsIdTeamsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdTeams(ctx context.Context, id openapi_types.UUID, body PostOrgsIdTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrgsIdTeamsTeamId request
+	DeleteOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *DeleteOrgsIdTeamsTeamIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdTeamsTeamId request
+	GetOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutOrgsIdTeamsTeamIdWithBody request with any body
+	PutOrgsIdTeamsTeamIdWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, body PutOrgsIdTeamsTeamIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdTeamsTeamIdMembersWithBody request with any body
+	PostOrgsIdTeamsTeamIdMembersWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdTeamsTeamIdMembers(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrgsIdTeamsTeamIdMembersUserId request
+	DeleteOrgsIdTeamsTeamIdMembersUserId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdTeamsTeamIdRulesWithBody request with any body
+	PostOrgsIdTeamsTeamIdRulesWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdTeamsTeamIdRules(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrgsIdTeamsTeamIdRulesRule request
+	DeleteOrgsIdTeamsTeamIdRulesRule(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, rule string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdUsage request
+	GetOrgsIdUsage(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdWebhooks request
+	GetOrgsIdWebhooks(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdWebhooksWithBody request with any body
+	PostOrgsIdWebhooksWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOrgsIdWebhooks(ctx context.Context, id openapi_types.UUID, body PostOrgsIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrgsIdWebhooksWebhookId request
+	DeleteOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdWebhooksWebhookId request
+	GetOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutOrgsIdWebhooksWebhookIdWithBody request with any body
+	PutOrgsIdWebhooksWebhookIdWithBody(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, body PutOrgsIdWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrgsIdWebhooksWebhookIdDeliveries request
+	GetOrgsIdWebhooksWebhookIdDeliveries(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, params *GetOrgsIdWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOrgsIdWebhooksWebhookIdTest request
+	PostOrgsIdWebhooksWebhookIdTest(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPaymentsProviderNotify request
+	PostPaymentsProviderNotify(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPlans request
+	GetPlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAdminScheduledTaskRuns(ctx context.Context, params *GetAdminScheduledTaskRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminScheduledTaskRunsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) PostAuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -257,6 +787,30 @@ func (c *Client) PostAuthCode(ctx context.Context, body PostAuthCodeJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostAuthDeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthDeleteAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthDeleteAccount(ctx context.Context, body PostAuthDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthDeleteAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostAuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostAuthLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -353,8 +907,8 @@ func (c *Client) PostAuthRegister(ctx context.Context, body PostAuthRegisterJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrgs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrgsRequest(c.Server)
+func (c *Client) PostDingtalkCallback(ctx context.Context, params *PostDingtalkCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDingtalkCallbackRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -365,899 +919,5599 @@ func (c *Client) GetOrgs(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-// NewPostAuthChangePasswordRequest calls the generic PostAuthChangePassword builder with application/json body
-func NewPostAuthChangePasswordRequest(server string, body PostAuthChangePasswordJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetOrgs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthChangePasswordRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthChangePasswordRequestWithBody generates requests for PostAuthChangePassword with any type of body
-func NewPostAuthChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgsIdMembers(ctx context.Context, id openapi_types.UUID, params *GetOrgsIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdMembersRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/change-password")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOrgsIdRechargesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdRechargesRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostOrgsIdRecharges(ctx context.Context, id openapi_types.UUID, body PostOrgsIdRechargesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdRechargesRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthCodeRequest calls the generic PostAuthCode builder with application/json body
-func NewPostAuthCodeRequest(server string, body PostAuthCodeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetOrgsIdRechargesOrderId(ctx context.Context, id openapi_types.UUID, orderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdRechargesOrderIdRequest(c.Server, id, orderId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthCodeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthCodeRequestWithBody generates requests for PostAuthCode with any type of body
-func NewPostAuthCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgsIdStatements(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdStatementsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/code")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOrgsIdStatementsMonth(ctx context.Context, id openapi_types.UUID, month string, params *GetOrgsIdStatementsMonthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdStatementsMonthRequest(c.Server, id, month, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAuthLoginRequest calls the generic PostAuthLogin builder with application/json body
-func NewPostAuthLoginRequest(server string, body PostAuthLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, params *DeleteOrgsIdSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrgsIdSubscriptionRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthLoginRequestWithBody generates requests for PostAuthLogin with any type of body
-func NewPostAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdSubscriptionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOrgsIdSubscriptionWithBody(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdSubscriptionRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAuthLogoutRequest generates requests for PostAuthLogout
-func NewPostAuthLogoutRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOrgsIdSubscription(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, body PostOrgsIdSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdSubscriptionRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOrgsIdTeams(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdTeamsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostOrgsIdTeamsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetAuthPingRequest generates requests for GetAuthPing
-func NewGetAuthPingRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOrgsIdTeams(ctx context.Context, id openapi_types.UUID, body PostOrgsIdTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/ping")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *DeleteOrgsIdTeamsTeamIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrgsIdTeamsTeamIdRequest(c.Server, id, teamId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdTeamsTeamIdRequest(c.Server, id, teamId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRefreshTokenRequest calls the generic PostAuthRefreshToken builder with application/json body
-func NewPostAuthRefreshTokenRequest(server string, body PostAuthRefreshTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutOrgsIdTeamsTeamIdWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgsIdTeamsTeamIdRequestWithBody(c.Server, id, teamId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRefreshTokenRequestWithBody generates requests for PostAuthRefreshToken with any type of body
-func NewPostAuthRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutOrgsIdTeamsTeamId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, body PutOrgsIdTeamsTeamIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgsIdTeamsTeamIdRequest(c.Server, id, teamId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/refresh-token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOrgsIdTeamsTeamIdMembersWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsTeamIdMembersRequestWithBody(c.Server, id, teamId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostOrgsIdTeamsTeamIdMembers(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsTeamIdMembersRequest(c.Server, id, teamId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRegisterRequest calls the generic PostAuthRegister builder with application/json body
-func NewPostAuthRegisterRequest(server string, body PostAuthRegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteOrgsIdTeamsTeamIdMembersUserId(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrgsIdTeamsTeamIdMembersUserIdRequest(c.Server, id, teamId, userId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRegisterRequestWithBody generates requests for PostAuthRegister with any type of body
-func NewPostAuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOrgsIdTeamsTeamIdRulesWithBody(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsTeamIdRulesRequestWithBody(c.Server, id, teamId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOrgsIdTeamsTeamIdRules(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdTeamsTeamIdRulesRequest(c.Server, id, teamId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DeleteOrgsIdTeamsTeamIdRulesRule(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, rule string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrgsIdTeamsTeamIdRulesRuleRequest(c.Server, id, teamId, rule)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetOrgsRequest generates requests for GetOrgs
-func NewGetOrgsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgsIdUsage(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdUsageRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/orgs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOrgsIdWebhooks(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdWebhooksRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostOrgsIdWebhooksWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdWebhooksRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostOrgsIdWebhooks(ctx context.Context, id openapi_types.UUID, body PostOrgsIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdWebhooksRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
+func (c *Client) DeleteOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrgsIdWebhooksWebhookIdRequest(c.Server, id, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdWebhooksWebhookIdRequest(c.Server, id, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutOrgsIdWebhooksWebhookIdWithBody(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgsIdWebhooksWebhookIdRequestWithBody(c.Server, id, webhookId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutOrgsIdWebhooksWebhookId(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, body PutOrgsIdWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgsIdWebhooksWebhookIdRequest(c.Server, id, webhookId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrgsIdWebhooksWebhookIdDeliveries(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, params *GetOrgsIdWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgsIdWebhooksWebhookIdDeliveriesRequest(c.Server, id, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostOrgsIdWebhooksWebhookIdTest(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOrgsIdWebhooksWebhookIdTestRequest(c.Server, id, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPaymentsProviderNotify(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPaymentsProviderNotifyRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPlansRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAdminScheduledTaskRunsRequest generates requests for GetAdminScheduledTaskRuns
+func NewGetAdminScheduledTaskRunsRequest(server string, params *GetAdminScheduledTaskRunsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/scheduled-task-runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Task != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "task", runtime.ParamLocationQuery, *params.Task); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthChangePasswordRequest calls the generic PostAuthChangePassword builder with application/json body
+func NewPostAuthChangePasswordRequest(server string, body PostAuthChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthChangePasswordRequestWithBody generates requests for PostAuthChangePassword with any type of body
+func NewPostAuthChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/change-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthCodeRequest calls the generic PostAuthCode builder with application/json body
+func NewPostAuthCodeRequest(server string, body PostAuthCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthCodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthCodeRequestWithBody generates requests for PostAuthCode with any type of body
+func NewPostAuthCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/code")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthDeleteAccountRequest calls the generic PostAuthDeleteAccount builder with application/json body
+func NewPostAuthDeleteAccountRequest(server string, body PostAuthDeleteAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthDeleteAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthDeleteAccountRequestWithBody generates requests for PostAuthDeleteAccount with any type of body
+func NewPostAuthDeleteAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/delete-account")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthLoginRequest calls the generic PostAuthLogin builder with application/json body
+func NewPostAuthLoginRequest(server string, body PostAuthLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthLoginRequestWithBody generates requests for PostAuthLogin with any type of body
+func NewPostAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthLogoutRequest generates requests for PostAuthLogout
+func NewPostAuthLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthPingRequest generates requests for GetAuthPing
+func NewGetAuthPingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/ping")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthRefreshTokenRequest calls the generic PostAuthRefreshToken builder with application/json body
+func NewPostAuthRefreshTokenRequest(server string, body PostAuthRefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthRefreshTokenRequestWithBody generates requests for PostAuthRefreshToken with any type of body
+func NewPostAuthRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/refresh-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthRegisterRequest calls the generic PostAuthRegister builder with application/json body
+func NewPostAuthRegisterRequest(server string, body PostAuthRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthRegisterRequestWithBody generates requests for PostAuthRegister with any type of body
+func NewPostAuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostDingtalkCallbackRequest generates requests for PostDingtalkCallback
+func NewPostDingtalkCallbackRequest(server string, params *PostDingtalkCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dingtalk/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, params.Signature); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timestamp", runtime.ParamLocationQuery, params.Timestamp); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nonce", runtime.ParamLocationQuery, params.Nonce); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsRequest generates requests for GetOrgs
+func NewGetOrgsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdMembersRequest generates requests for GetOrgsIdMembers
+func NewGetOrgsIdMembersRequest(server string, id openapi_types.UUID, params *GetOrgsIdMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdRechargesRequest calls the generic PostOrgsIdRecharges builder with application/json body
+func NewPostOrgsIdRechargesRequest(server string, id openapi_types.UUID, body PostOrgsIdRechargesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdRechargesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdRechargesRequestWithBody generates requests for PostOrgsIdRecharges with any type of body
+func NewPostOrgsIdRechargesRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/recharges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOrgsIdRechargesOrderIdRequest generates requests for GetOrgsIdRechargesOrderId
+func NewGetOrgsIdRechargesOrderIdRequest(server string, id openapi_types.UUID, orderId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "orderId", runtime.ParamLocationPath, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/recharges/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdStatementsRequest generates requests for GetOrgsIdStatements
+func NewGetOrgsIdStatementsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/statements", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdStatementsMonthRequest generates requests for GetOrgsIdStatementsMonth
+func NewGetOrgsIdStatementsMonthRequest(server string, id openapi_types.UUID, month string, params *GetOrgsIdStatementsMonthParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "month", runtime.ParamLocationPath, month)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/statements/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteOrgsIdSubscriptionRequest generates requests for DeleteOrgsIdSubscription
+func NewDeleteOrgsIdSubscriptionRequest(server string, id openapi_types.UUID, params *DeleteOrgsIdSubscriptionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/subscription", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdSubscriptionRequest generates requests for GetOrgsIdSubscription
+func NewGetOrgsIdSubscriptionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/subscription", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdSubscriptionRequest calls the generic PostOrgsIdSubscription builder with application/json body
+func NewPostOrgsIdSubscriptionRequest(server string, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, body PostOrgsIdSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdSubscriptionRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdSubscriptionRequestWithBody generates requests for PostOrgsIdSubscription with any type of body
+func NewPostOrgsIdSubscriptionRequestWithBody(server string, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/subscription", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdTeamsRequest generates requests for GetOrgsIdTeams
+func NewGetOrgsIdTeamsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdTeamsRequest calls the generic PostOrgsIdTeams builder with application/json body
+func NewPostOrgsIdTeamsRequest(server string, id openapi_types.UUID, body PostOrgsIdTeamsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdTeamsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdTeamsRequestWithBody generates requests for PostOrgsIdTeams with any type of body
+func NewPostOrgsIdTeamsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrgsIdTeamsTeamIdRequest generates requests for DeleteOrgsIdTeamsTeamId
+func NewDeleteOrgsIdTeamsTeamIdRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, params *DeleteOrgsIdTeamsTeamIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdTeamsTeamIdRequest generates requests for GetOrgsIdTeamsTeamId
+func NewGetOrgsIdTeamsTeamIdRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutOrgsIdTeamsTeamIdRequest calls the generic PutOrgsIdTeamsTeamId builder with application/json body
+func NewPutOrgsIdTeamsTeamIdRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, body PutOrgsIdTeamsTeamIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOrgsIdTeamsTeamIdRequestWithBody(server, id, teamId, params, "application/json", bodyReader)
+}
+
+// NewPutOrgsIdTeamsTeamIdRequestWithBody generates requests for PutOrgsIdTeamsTeamId with any type of body
+func NewPutOrgsIdTeamsTeamIdRequestWithBody(server string, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdTeamsTeamIdMembersRequest calls the generic PostOrgsIdTeamsTeamIdMembers builder with application/json body
+func NewPostOrgsIdTeamsTeamIdMembersRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdTeamsTeamIdMembersRequestWithBody(server, id, teamId, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdTeamsTeamIdMembersRequestWithBody generates requests for PostOrgsIdTeamsTeamIdMembers with any type of body
+func NewPostOrgsIdTeamsTeamIdMembersRequestWithBody(server string, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s/members", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrgsIdTeamsTeamIdMembersUserIdRequest generates requests for DeleteOrgsIdTeamsTeamIdMembersUserId
+func NewDeleteOrgsIdTeamsTeamIdMembersUserIdRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s/members/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdTeamsTeamIdRulesRequest calls the generic PostOrgsIdTeamsTeamIdRules builder with application/json body
+func NewPostOrgsIdTeamsTeamIdRulesRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdTeamsTeamIdRulesRequestWithBody(server, id, teamId, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdTeamsTeamIdRulesRequestWithBody generates requests for PostOrgsIdTeamsTeamIdRules with any type of body
+func NewPostOrgsIdTeamsTeamIdRulesRequestWithBody(server string, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s/rules", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrgsIdTeamsTeamIdRulesRuleRequest generates requests for DeleteOrgsIdTeamsTeamIdRulesRule
+func NewDeleteOrgsIdTeamsTeamIdRulesRuleRequest(server string, id openapi_types.UUID, teamId openapi_types.UUID, rule string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "teamId", runtime.ParamLocationPath, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "rule", runtime.ParamLocationPath, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/teams/%s/rules/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdUsageRequest generates requests for GetOrgsIdUsage
+func NewGetOrgsIdUsageRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/usage", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdWebhooksRequest generates requests for GetOrgsIdWebhooks
+func NewGetOrgsIdWebhooksRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdWebhooksRequest calls the generic PostOrgsIdWebhooks builder with application/json body
+func NewPostOrgsIdWebhooksRequest(server string, id openapi_types.UUID, body PostOrgsIdWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOrgsIdWebhooksRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostOrgsIdWebhooksRequestWithBody generates requests for PostOrgsIdWebhooks with any type of body
+func NewPostOrgsIdWebhooksRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrgsIdWebhooksWebhookIdRequest generates requests for DeleteOrgsIdWebhooksWebhookId
+func NewDeleteOrgsIdWebhooksWebhookIdRequest(server string, id openapi_types.UUID, webhookId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgsIdWebhooksWebhookIdRequest generates requests for GetOrgsIdWebhooksWebhookId
+func NewGetOrgsIdWebhooksWebhookIdRequest(server string, id openapi_types.UUID, webhookId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutOrgsIdWebhooksWebhookIdRequest calls the generic PutOrgsIdWebhooksWebhookId builder with application/json body
+func NewPutOrgsIdWebhooksWebhookIdRequest(server string, id openapi_types.UUID, webhookId openapi_types.UUID, body PutOrgsIdWebhooksWebhookIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOrgsIdWebhooksWebhookIdRequestWithBody(server, id, webhookId, "application/json", bodyReader)
+}
+
+// NewPutOrgsIdWebhooksWebhookIdRequestWithBody generates requests for PutOrgsIdWebhooksWebhookId with any type of body
+func NewPutOrgsIdWebhooksWebhookIdRequestWithBody(server string, id openapi_types.UUID, webhookId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOrgsIdWebhooksWebhookIdDeliveriesRequest generates requests for GetOrgsIdWebhooksWebhookIdDeliveries
+func NewGetOrgsIdWebhooksWebhookIdDeliveriesRequest(server string, id openapi_types.UUID, webhookId openapi_types.UUID, params *GetOrgsIdWebhooksWebhookIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks/%s/deliveries", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOrgsIdWebhooksWebhookIdTestRequest generates requests for PostOrgsIdWebhooksWebhookIdTest
+func NewPostOrgsIdWebhooksWebhookIdTestRequest(server string, id openapi_types.UUID, webhookId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/webhooks/%s/test", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPaymentsProviderNotifyRequest generates requests for PostPaymentsProviderNotify
+func NewPostPaymentsProviderNotifyRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/payments/%s/notify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPlansRequest generates requests for GetPlans
+func NewGetPlansRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/plans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminScheduledTaskRunsWithResponse request
+	GetAdminScheduledTaskRunsWithResponse(ctx context.Context, params *GetAdminScheduledTaskRunsParams, reqEditors ...RequestEditorFn) (*GetAdminScheduledTaskRunsResponse, error)
+
+	// PostAuthChangePasswordWithBodyWithResponse request with any body
+	PostAuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error)
+
+	PostAuthChangePasswordWithResponse(ctx context.Context, body PostAuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error)
+
+	// PostAuthCodeWithBodyWithResponse request with any body
+	PostAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error)
+
+	PostAuthCodeWithResponse(ctx context.Context, body PostAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error)
+
+	// PostAuthDeleteAccountWithBodyWithResponse request with any body
+	PostAuthDeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthDeleteAccountResponse, error)
+
+	PostAuthDeleteAccountWithResponse(ctx context.Context, body PostAuthDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthDeleteAccountResponse, error)
+
+	// PostAuthLoginWithBodyWithResponse request with any body
+	PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	// PostAuthLogoutWithResponse request
+	PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	// GetAuthPingWithResponse request
+	GetAuthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthPingResponse, error)
+
+	// PostAuthRefreshTokenWithBodyWithResponse request with any body
+	PostAuthRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error)
+
+	PostAuthRefreshTokenWithResponse(ctx context.Context, body PostAuthRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error)
+
+	// PostAuthRegisterWithBodyWithResponse request with any body
+	PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+
+	PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+
+	// PostDingtalkCallbackWithResponse request
+	PostDingtalkCallbackWithResponse(ctx context.Context, params *PostDingtalkCallbackParams, reqEditors ...RequestEditorFn) (*PostDingtalkCallbackResponse, error)
+
+	// GetOrgsWithResponse request
+	GetOrgsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgsResponse, error)
+
+	// GetOrgsIdMembersWithResponse request
+	GetOrgsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, params *GetOrgsIdMembersParams, reqEditors ...RequestEditorFn) (*GetOrgsIdMembersResponse, error)
+
+	// PostOrgsIdRechargesWithBodyWithResponse request with any body
+	PostOrgsIdRechargesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdRechargesResponse, error)
+
+	PostOrgsIdRechargesWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdRechargesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdRechargesResponse, error)
+
+	// GetOrgsIdRechargesOrderIdWithResponse request
+	GetOrgsIdRechargesOrderIdWithResponse(ctx context.Context, id openapi_types.UUID, orderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdRechargesOrderIdResponse, error)
+
+	// GetOrgsIdStatementsWithResponse request
+	GetOrgsIdStatementsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdStatementsResponse, error)
+
+	// GetOrgsIdStatementsMonthWithResponse request
+	GetOrgsIdStatementsMonthWithResponse(ctx context.Context, id openapi_types.UUID, month string, params *GetOrgsIdStatementsMonthParams, reqEditors ...RequestEditorFn) (*GetOrgsIdStatementsMonthResponse, error)
+
+	// DeleteOrgsIdSubscriptionWithResponse request
+	DeleteOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteOrgsIdSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteOrgsIdSubscriptionResponse, error)
+
+	// GetOrgsIdSubscriptionWithResponse request
+	GetOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdSubscriptionResponse, error)
+
+	// PostOrgsIdSubscriptionWithBodyWithResponse request with any body
+	PostOrgsIdSubscriptionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdSubscriptionResponse, error)
+
+	PostOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, body PostOrgsIdSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdSubscriptionResponse, error)
+
+	// GetOrgsIdTeamsWithResponse request
+	GetOrgsIdTeamsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdTeamsResponse, error)
+
+	// PostOrgsIdTeamsWithBodyWithResponse request with any body
+	PostOrgsIdTeamsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsResponse, error)
+
+	PostOrgsIdTeamsWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsResponse, error)
+
+	// DeleteOrgsIdTeamsTeamIdWithResponse request
+	DeleteOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *DeleteOrgsIdTeamsTeamIdParams, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdResponse, error)
+
+	// GetOrgsIdTeamsTeamIdWithResponse request
+	GetOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdTeamsTeamIdResponse, error)
+
+	// PutOrgsIdTeamsTeamIdWithBodyWithResponse request with any body
+	PutOrgsIdTeamsTeamIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgsIdTeamsTeamIdResponse, error)
+
+	PutOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, body PutOrgsIdTeamsTeamIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgsIdTeamsTeamIdResponse, error)
+
+	// PostOrgsIdTeamsTeamIdMembersWithBodyWithResponse request with any body
+	PostOrgsIdTeamsTeamIdMembersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdMembersResponse, error)
+
+	PostOrgsIdTeamsTeamIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdMembersResponse, error)
+
+	// DeleteOrgsIdTeamsTeamIdMembersUserIdWithResponse request
+	DeleteOrgsIdTeamsTeamIdMembersUserIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdMembersUserIdResponse, error)
+
+	// PostOrgsIdTeamsTeamIdRulesWithBodyWithResponse request with any body
+	PostOrgsIdTeamsTeamIdRulesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdRulesResponse, error)
+
+	PostOrgsIdTeamsTeamIdRulesWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdRulesResponse, error)
+
+	// DeleteOrgsIdTeamsTeamIdRulesRuleWithResponse request
+	DeleteOrgsIdTeamsTeamIdRulesRuleWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, rule string, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdRulesRuleResponse, error)
+
+	// GetOrgsIdUsageWithResponse request
+	GetOrgsIdUsageWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdUsageResponse, error)
+
+	// GetOrgsIdWebhooksWithResponse request
+	GetOrgsIdWebhooksWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksResponse, error)
+
+	// PostOrgsIdWebhooksWithBodyWithResponse request with any body
+	PostOrgsIdWebhooksWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksResponse, error)
+
+	PostOrgsIdWebhooksWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksResponse, error)
+
+	// DeleteOrgsIdWebhooksWebhookIdWithResponse request
+	DeleteOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteOrgsIdWebhooksWebhookIdResponse, error)
+
+	// GetOrgsIdWebhooksWebhookIdWithResponse request
+	GetOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksWebhookIdResponse, error)
+
+	// PutOrgsIdWebhooksWebhookIdWithBodyWithResponse request with any body
+	PutOrgsIdWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgsIdWebhooksWebhookIdResponse, error)
+
+	PutOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, body PutOrgsIdWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgsIdWebhooksWebhookIdResponse, error)
+
+	// GetOrgsIdWebhooksWebhookIdDeliveriesWithResponse request
+	GetOrgsIdWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, params *GetOrgsIdWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksWebhookIdDeliveriesResponse, error)
+
+	// PostOrgsIdWebhooksWebhookIdTestWithResponse request
+	PostOrgsIdWebhooksWebhookIdTestWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksWebhookIdTestResponse, error)
+
+	// PostPaymentsProviderNotifyWithResponse request
+	PostPaymentsProviderNotifyWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*PostPaymentsProviderNotifyResponse, error)
+
+	// GetPlansWithResponse request
+	GetPlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPlansResponse, error)
+}
+
+type GetAdminScheduledTaskRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledTaskRunPage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminScheduledTaskRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminScheduledTaskRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthDeleteAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthDeleteAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthDeleteAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthPingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthPingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthPingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRefreshTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		RefreshToken string `json:"refreshToken"`
+		Token        string `json:"token"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDingtalkCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DingTalkCallbackResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDingtalkCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDingtalkCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrgInfoRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrgMemberPage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdRechargesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RechargeOrder
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdRechargesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdRechargesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdRechargesOrderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RechargeOrder
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdRechargesOrderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdRechargesOrderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdStatementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Statement
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdStatementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdStatementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdStatementsMonthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatementDetail
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdStatementsMonthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdStatementsMonthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrgsIdSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrgsIdSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrgsIdSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Subscription
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Subscription
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Team
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrgsIdTeamsTeamIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrgsIdTeamsTeamIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrgsIdTeamsTeamIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdTeamsTeamIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamDetail
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdTeamsTeamIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdTeamsTeamIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOrgsIdTeamsTeamIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOrgsIdTeamsTeamIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOrgsIdTeamsTeamIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdTeamsTeamIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdTeamsTeamIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdTeamsTeamIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrgsIdTeamsTeamIdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrgsIdTeamsTeamIdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrgsIdTeamsTeamIdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdTeamsTeamIdRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdTeamsTeamIdRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdTeamsTeamIdRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrgsIdTeamsTeamIdRulesRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrgsIdTeamsTeamIdRulesRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrgsIdTeamsTeamIdRulesRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrgUsage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrgsIdWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrgsIdWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrgsIdWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOrgsIdWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOrgsIdWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOrgsIdWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgsIdWebhooksWebhookIdDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryPage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgsIdWebhooksWebhookIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgsIdWebhooksWebhookIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOrgsIdWebhooksWebhookIdTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOrgsIdWebhooksWebhookIdTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOrgsIdWebhooksWebhookIdTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPaymentsProviderNotifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPaymentsProviderNotifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPaymentsProviderNotifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPlansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Plan
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPlansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPlansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminScheduledTaskRunsWithResponse request returning *GetAdminScheduledTaskRunsResponse
+func (c *ClientWithResponses) GetAdminScheduledTaskRunsWithResponse(ctx context.Context, params *GetAdminScheduledTaskRunsParams, reqEditors ...RequestEditorFn) (*GetAdminScheduledTaskRunsResponse, error) {
+	rsp, err := c.GetAdminScheduledTaskRuns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminScheduledTaskRunsResponse(rsp)
+}
+
+// PostAuthChangePasswordWithBodyWithResponse request with arbitrary body returning *PostAuthChangePasswordResponse
+func (c *ClientWithResponses) PostAuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error) {
+	rsp, err := c.PostAuthChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthChangePasswordWithResponse(ctx context.Context, body PostAuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error) {
+	rsp, err := c.PostAuthChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthChangePasswordResponse(rsp)
+}
+
+// PostAuthCodeWithBodyWithResponse request with arbitrary body returning *PostAuthCodeResponse
+func (c *ClientWithResponses) PostAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error) {
+	rsp, err := c.PostAuthCodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthCodeWithResponse(ctx context.Context, body PostAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error) {
+	rsp, err := c.PostAuthCode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthCodeResponse(rsp)
+}
+
+// PostAuthDeleteAccountWithBodyWithResponse request with arbitrary body returning *PostAuthDeleteAccountResponse
+func (c *ClientWithResponses) PostAuthDeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthDeleteAccountResponse, error) {
+	rsp, err := c.PostAuthDeleteAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthDeleteAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthDeleteAccountWithResponse(ctx context.Context, body PostAuthDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthDeleteAccountResponse, error) {
+	rsp, err := c.PostAuthDeleteAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthDeleteAccountResponse(rsp)
+}
+
+// PostAuthLoginWithBodyWithResponse request with arbitrary body returning *PostAuthLoginResponse
+func (c *ClientWithResponses) PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+// PostAuthLogoutWithResponse request returning *PostAuthLogoutResponse
+func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+// GetAuthPingWithResponse request returning *GetAuthPingResponse
+func (c *ClientWithResponses) GetAuthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthPingResponse, error) {
+	rsp, err := c.GetAuthPing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthPingResponse(rsp)
+}
+
+// PostAuthRefreshTokenWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshTokenResponse
+func (c *ClientWithResponses) PostAuthRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error) {
+	rsp, err := c.PostAuthRefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRefreshTokenWithResponse(ctx context.Context, body PostAuthRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error) {
+	rsp, err := c.PostAuthRefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshTokenResponse(rsp)
+}
+
+// PostAuthRegisterWithBodyWithResponse request with arbitrary body returning *PostAuthRegisterResponse
+func (c *ClientWithResponses) PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
+	rsp, err := c.PostAuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
+	rsp, err := c.PostAuthRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRegisterResponse(rsp)
+}
+
+// PostDingtalkCallbackWithResponse request returning *PostDingtalkCallbackResponse
+func (c *ClientWithResponses) PostDingtalkCallbackWithResponse(ctx context.Context, params *PostDingtalkCallbackParams, reqEditors ...RequestEditorFn) (*PostDingtalkCallbackResponse, error) {
+	rsp, err := c.PostDingtalkCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDingtalkCallbackResponse(rsp)
+}
+
+// GetOrgsWithResponse request returning *GetOrgsResponse
+func (c *ClientWithResponses) GetOrgsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgsResponse, error) {
+	rsp, err := c.GetOrgs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsResponse(rsp)
+}
+
+// GetOrgsIdMembersWithResponse request returning *GetOrgsIdMembersResponse
+func (c *ClientWithResponses) GetOrgsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, params *GetOrgsIdMembersParams, reqEditors ...RequestEditorFn) (*GetOrgsIdMembersResponse, error) {
+	rsp, err := c.GetOrgsIdMembers(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdMembersResponse(rsp)
+}
+
+// PostOrgsIdRechargesWithBodyWithResponse request with arbitrary body returning *PostOrgsIdRechargesResponse
+func (c *ClientWithResponses) PostOrgsIdRechargesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdRechargesResponse, error) {
+	rsp, err := c.PostOrgsIdRechargesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdRechargesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdRechargesWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdRechargesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdRechargesResponse, error) {
+	rsp, err := c.PostOrgsIdRecharges(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdRechargesResponse(rsp)
+}
+
+// GetOrgsIdRechargesOrderIdWithResponse request returning *GetOrgsIdRechargesOrderIdResponse
+func (c *ClientWithResponses) GetOrgsIdRechargesOrderIdWithResponse(ctx context.Context, id openapi_types.UUID, orderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdRechargesOrderIdResponse, error) {
+	rsp, err := c.GetOrgsIdRechargesOrderId(ctx, id, orderId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdRechargesOrderIdResponse(rsp)
+}
+
+// GetOrgsIdStatementsWithResponse request returning *GetOrgsIdStatementsResponse
+func (c *ClientWithResponses) GetOrgsIdStatementsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdStatementsResponse, error) {
+	rsp, err := c.GetOrgsIdStatements(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdStatementsResponse(rsp)
+}
+
+// GetOrgsIdStatementsMonthWithResponse request returning *GetOrgsIdStatementsMonthResponse
+func (c *ClientWithResponses) GetOrgsIdStatementsMonthWithResponse(ctx context.Context, id openapi_types.UUID, month string, params *GetOrgsIdStatementsMonthParams, reqEditors ...RequestEditorFn) (*GetOrgsIdStatementsMonthResponse, error) {
+	rsp, err := c.GetOrgsIdStatementsMonth(ctx, id, month, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdStatementsMonthResponse(rsp)
+}
+
+// DeleteOrgsIdSubscriptionWithResponse request returning *DeleteOrgsIdSubscriptionResponse
+func (c *ClientWithResponses) DeleteOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteOrgsIdSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteOrgsIdSubscriptionResponse, error) {
+	rsp, err := c.DeleteOrgsIdSubscription(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrgsIdSubscriptionResponse(rsp)
+}
+
+// GetOrgsIdSubscriptionWithResponse request returning *GetOrgsIdSubscriptionResponse
+func (c *ClientWithResponses) GetOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdSubscriptionResponse, error) {
+	rsp, err := c.GetOrgsIdSubscription(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdSubscriptionResponse(rsp)
+}
+
+// PostOrgsIdSubscriptionWithBodyWithResponse request with arbitrary body returning *PostOrgsIdSubscriptionResponse
+func (c *ClientWithResponses) PostOrgsIdSubscriptionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdSubscriptionResponse, error) {
+	rsp, err := c.PostOrgsIdSubscriptionWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdSubscriptionWithResponse(ctx context.Context, id openapi_types.UUID, params *PostOrgsIdSubscriptionParams, body PostOrgsIdSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdSubscriptionResponse, error) {
+	rsp, err := c.PostOrgsIdSubscription(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdSubscriptionResponse(rsp)
+}
+
+// GetOrgsIdTeamsWithResponse request returning *GetOrgsIdTeamsResponse
+func (c *ClientWithResponses) GetOrgsIdTeamsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdTeamsResponse, error) {
+	rsp, err := c.GetOrgsIdTeams(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdTeamsResponse(rsp)
+}
+
+// PostOrgsIdTeamsWithBodyWithResponse request with arbitrary body returning *PostOrgsIdTeamsResponse
+func (c *ClientWithResponses) PostOrgsIdTeamsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsResponse, error) {
+	rsp, err := c.PostOrgsIdTeamsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdTeamsWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsResponse, error) {
+	rsp, err := c.PostOrgsIdTeams(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsResponse(rsp)
+}
+
+// DeleteOrgsIdTeamsTeamIdWithResponse request returning *DeleteOrgsIdTeamsTeamIdResponse
+func (c *ClientWithResponses) DeleteOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *DeleteOrgsIdTeamsTeamIdParams, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdResponse, error) {
+	rsp, err := c.DeleteOrgsIdTeamsTeamId(ctx, id, teamId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrgsIdTeamsTeamIdResponse(rsp)
+}
+
+// GetOrgsIdTeamsTeamIdWithResponse request returning *GetOrgsIdTeamsTeamIdResponse
+func (c *ClientWithResponses) GetOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdTeamsTeamIdResponse, error) {
+	rsp, err := c.GetOrgsIdTeamsTeamId(ctx, id, teamId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdTeamsTeamIdResponse(rsp)
+}
+
+// PutOrgsIdTeamsTeamIdWithBodyWithResponse request with arbitrary body returning *PutOrgsIdTeamsTeamIdResponse
+func (c *ClientWithResponses) PutOrgsIdTeamsTeamIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgsIdTeamsTeamIdResponse, error) {
+	rsp, err := c.PutOrgsIdTeamsTeamIdWithBody(ctx, id, teamId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgsIdTeamsTeamIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutOrgsIdTeamsTeamIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, params *PutOrgsIdTeamsTeamIdParams, body PutOrgsIdTeamsTeamIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgsIdTeamsTeamIdResponse, error) {
+	rsp, err := c.PutOrgsIdTeamsTeamId(ctx, id, teamId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgsIdTeamsTeamIdResponse(rsp)
+}
+
+// PostOrgsIdTeamsTeamIdMembersWithBodyWithResponse request with arbitrary body returning *PostOrgsIdTeamsTeamIdMembersResponse
+func (c *ClientWithResponses) PostOrgsIdTeamsTeamIdMembersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdMembersResponse, error) {
+	rsp, err := c.PostOrgsIdTeamsTeamIdMembersWithBody(ctx, id, teamId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsTeamIdMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdTeamsTeamIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdMembersResponse, error) {
+	rsp, err := c.PostOrgsIdTeamsTeamIdMembers(ctx, id, teamId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsTeamIdMembersResponse(rsp)
+}
+
+// DeleteOrgsIdTeamsTeamIdMembersUserIdWithResponse request returning *DeleteOrgsIdTeamsTeamIdMembersUserIdResponse
+func (c *ClientWithResponses) DeleteOrgsIdTeamsTeamIdMembersUserIdWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdMembersUserIdResponse, error) {
+	rsp, err := c.DeleteOrgsIdTeamsTeamIdMembersUserId(ctx, id, teamId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrgsIdTeamsTeamIdMembersUserIdResponse(rsp)
+}
+
+// PostOrgsIdTeamsTeamIdRulesWithBodyWithResponse request with arbitrary body returning *PostOrgsIdTeamsTeamIdRulesResponse
+func (c *ClientWithResponses) PostOrgsIdTeamsTeamIdRulesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdRulesResponse, error) {
+	rsp, err := c.PostOrgsIdTeamsTeamIdRulesWithBody(ctx, id, teamId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsTeamIdRulesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdTeamsTeamIdRulesWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, body PostOrgsIdTeamsTeamIdRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdTeamsTeamIdRulesResponse, error) {
+	rsp, err := c.PostOrgsIdTeamsTeamIdRules(ctx, id, teamId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdTeamsTeamIdRulesResponse(rsp)
+}
+
+// DeleteOrgsIdTeamsTeamIdRulesRuleWithResponse request returning *DeleteOrgsIdTeamsTeamIdRulesRuleResponse
+func (c *ClientWithResponses) DeleteOrgsIdTeamsTeamIdRulesRuleWithResponse(ctx context.Context, id openapi_types.UUID, teamId openapi_types.UUID, rule string, reqEditors ...RequestEditorFn) (*DeleteOrgsIdTeamsTeamIdRulesRuleResponse, error) {
+	rsp, err := c.DeleteOrgsIdTeamsTeamIdRulesRule(ctx, id, teamId, rule, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrgsIdTeamsTeamIdRulesRuleResponse(rsp)
+}
+
+// GetOrgsIdUsageWithResponse request returning *GetOrgsIdUsageResponse
+func (c *ClientWithResponses) GetOrgsIdUsageWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdUsageResponse, error) {
+	rsp, err := c.GetOrgsIdUsage(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdUsageResponse(rsp)
+}
+
+// GetOrgsIdWebhooksWithResponse request returning *GetOrgsIdWebhooksResponse
+func (c *ClientWithResponses) GetOrgsIdWebhooksWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksResponse, error) {
+	rsp, err := c.GetOrgsIdWebhooks(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdWebhooksResponse(rsp)
+}
+
+// PostOrgsIdWebhooksWithBodyWithResponse request with arbitrary body returning *PostOrgsIdWebhooksResponse
+func (c *ClientWithResponses) PostOrgsIdWebhooksWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksResponse, error) {
+	rsp, err := c.PostOrgsIdWebhooksWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOrgsIdWebhooksWithResponse(ctx context.Context, id openapi_types.UUID, body PostOrgsIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksResponse, error) {
+	rsp, err := c.PostOrgsIdWebhooks(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdWebhooksResponse(rsp)
+}
+
+// DeleteOrgsIdWebhooksWebhookIdWithResponse request returning *DeleteOrgsIdWebhooksWebhookIdResponse
+func (c *ClientWithResponses) DeleteOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteOrgsIdWebhooksWebhookIdResponse, error) {
+	rsp, err := c.DeleteOrgsIdWebhooksWebhookId(ctx, id, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrgsIdWebhooksWebhookIdResponse(rsp)
+}
+
+// GetOrgsIdWebhooksWebhookIdWithResponse request returning *GetOrgsIdWebhooksWebhookIdResponse
+func (c *ClientWithResponses) GetOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksWebhookIdResponse, error) {
+	rsp, err := c.GetOrgsIdWebhooksWebhookId(ctx, id, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdWebhooksWebhookIdResponse(rsp)
+}
+
+// PutOrgsIdWebhooksWebhookIdWithBodyWithResponse request with arbitrary body returning *PutOrgsIdWebhooksWebhookIdResponse
+func (c *ClientWithResponses) PutOrgsIdWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgsIdWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutOrgsIdWebhooksWebhookIdWithBody(ctx, id, webhookId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgsIdWebhooksWebhookIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutOrgsIdWebhooksWebhookIdWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, body PutOrgsIdWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgsIdWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutOrgsIdWebhooksWebhookId(ctx, id, webhookId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgsIdWebhooksWebhookIdResponse(rsp)
+}
+
+// GetOrgsIdWebhooksWebhookIdDeliveriesWithResponse request returning *GetOrgsIdWebhooksWebhookIdDeliveriesResponse
+func (c *ClientWithResponses) GetOrgsIdWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, params *GetOrgsIdWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetOrgsIdWebhooksWebhookIdDeliveriesResponse, error) {
+	rsp, err := c.GetOrgsIdWebhooksWebhookIdDeliveries(ctx, id, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgsIdWebhooksWebhookIdDeliveriesResponse(rsp)
+}
+
+// PostOrgsIdWebhooksWebhookIdTestWithResponse request returning *PostOrgsIdWebhooksWebhookIdTestResponse
+func (c *ClientWithResponses) PostOrgsIdWebhooksWebhookIdTestWithResponse(ctx context.Context, id openapi_types.UUID, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostOrgsIdWebhooksWebhookIdTestResponse, error) {
+	rsp, err := c.PostOrgsIdWebhooksWebhookIdTest(ctx, id, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOrgsIdWebhooksWebhookIdTestResponse(rsp)
+}
+
+// PostPaymentsProviderNotifyWithResponse request returning *PostPaymentsProviderNotifyResponse
+func (c *ClientWithResponses) PostPaymentsProviderNotifyWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*PostPaymentsProviderNotifyResponse, error) {
+	rsp, err := c.PostPaymentsProviderNotify(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPaymentsProviderNotifyResponse(rsp)
+}
+
+// GetPlansWithResponse request returning *GetPlansResponse
+func (c *ClientWithResponses) GetPlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPlansResponse, error) {
+	rsp, err := c.GetPlans(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPlansResponse(rsp)
+}
+
+// ParseGetAdminScheduledTaskRunsResponse parses an HTTP response from a GetAdminScheduledTaskRunsWithResponse call
+func ParseGetAdminScheduledTaskRunsResponse(rsp *http.Response) (*GetAdminScheduledTaskRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminScheduledTaskRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTaskRunPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthChangePasswordResponse parses an HTTP response from a PostAuthChangePasswordWithResponse call
+func ParsePostAuthChangePasswordResponse(rsp *http.Response) (*PostAuthChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthCodeResponse parses an HTTP response from a PostAuthCodeWithResponse call
+func ParsePostAuthCodeResponse(rsp *http.Response) (*PostAuthCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthDeleteAccountResponse parses an HTTP response from a PostAuthDeleteAccountWithResponse call
+func ParsePostAuthDeleteAccountResponse(rsp *http.Response) (*PostAuthDeleteAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthDeleteAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLoginResponse parses an HTTP response from a PostAuthLoginWithResponse call
+func ParsePostAuthLoginResponse(rsp *http.Response) (*PostAuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
+func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthPingResponse parses an HTTP response from a GetAuthPingWithResponse call
+func ParseGetAuthPingResponse(rsp *http.Response) (*GetAuthPingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthPingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRefreshTokenResponse parses an HTTP response from a PostAuthRefreshTokenWithResponse call
+func ParsePostAuthRefreshTokenResponse(rsp *http.Response) (*PostAuthRefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			RefreshToken string `json:"refreshToken"`
+			Token        string `json:"token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRegisterResponse parses an HTTP response from a PostAuthRegisterWithResponse call
+func ParsePostAuthRegisterResponse(rsp *http.Response) (*PostAuthRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostDingtalkCallbackResponse parses an HTTP response from a PostDingtalkCallbackWithResponse call
+func ParsePostDingtalkCallbackResponse(rsp *http.Response) (*PostDingtalkCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDingtalkCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DingTalkCallbackResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsResponse parses an HTTP response from a GetOrgsWithResponse call
+func ParseGetOrgsResponse(rsp *http.Response) (*GetOrgsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrgInfoRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdMembersResponse parses an HTTP response from a GetOrgsIdMembersWithResponse call
+func ParseGetOrgsIdMembersResponse(rsp *http.Response) (*GetOrgsIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrgMemberPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdRechargesResponse parses an HTTP response from a PostOrgsIdRechargesWithResponse call
+func ParsePostOrgsIdRechargesResponse(rsp *http.Response) (*PostOrgsIdRechargesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdRechargesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RechargeOrder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdRechargesOrderIdResponse parses an HTTP response from a GetOrgsIdRechargesOrderIdWithResponse call
+func ParseGetOrgsIdRechargesOrderIdResponse(rsp *http.Response) (*GetOrgsIdRechargesOrderIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdRechargesOrderIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RechargeOrder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdStatementsResponse parses an HTTP response from a GetOrgsIdStatementsWithResponse call
+func ParseGetOrgsIdStatementsResponse(rsp *http.Response) (*GetOrgsIdStatementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdStatementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Statement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdStatementsMonthResponse parses an HTTP response from a GetOrgsIdStatementsMonthWithResponse call
+func ParseGetOrgsIdStatementsMonthResponse(rsp *http.Response) (*GetOrgsIdStatementsMonthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdStatementsMonthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatementDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrgsIdSubscriptionResponse parses an HTTP response from a DeleteOrgsIdSubscriptionWithResponse call
+func ParseDeleteOrgsIdSubscriptionResponse(rsp *http.Response) (*DeleteOrgsIdSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrgsIdSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdSubscriptionResponse parses an HTTP response from a GetOrgsIdSubscriptionWithResponse call
+func ParseGetOrgsIdSubscriptionResponse(rsp *http.Response) (*GetOrgsIdSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdSubscriptionResponse parses an HTTP response from a PostOrgsIdSubscriptionWithResponse call
+func ParsePostOrgsIdSubscriptionResponse(rsp *http.Response) (*PostOrgsIdSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdTeamsResponse parses an HTTP response from a GetOrgsIdTeamsWithResponse call
+func ParseGetOrgsIdTeamsResponse(rsp *http.Response) (*GetOrgsIdTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdTeamsResponse parses an HTTP response from a PostOrgsIdTeamsWithResponse call
+func ParsePostOrgsIdTeamsResponse(rsp *http.Response) (*PostOrgsIdTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrgsIdTeamsTeamIdResponse parses an HTTP response from a DeleteOrgsIdTeamsTeamIdWithResponse call
+func ParseDeleteOrgsIdTeamsTeamIdResponse(rsp *http.Response) (*DeleteOrgsIdTeamsTeamIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrgsIdTeamsTeamIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdTeamsTeamIdResponse parses an HTTP response from a GetOrgsIdTeamsTeamIdWithResponse call
+func ParseGetOrgsIdTeamsTeamIdResponse(rsp *http.Response) (*GetOrgsIdTeamsTeamIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdTeamsTeamIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutOrgsIdTeamsTeamIdResponse parses an HTTP response from a PutOrgsIdTeamsTeamIdWithResponse call
+func ParsePutOrgsIdTeamsTeamIdResponse(rsp *http.Response) (*PutOrgsIdTeamsTeamIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutOrgsIdTeamsTeamIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdTeamsTeamIdMembersResponse parses an HTTP response from a PostOrgsIdTeamsTeamIdMembersWithResponse call
+func ParsePostOrgsIdTeamsTeamIdMembersResponse(rsp *http.Response) (*PostOrgsIdTeamsTeamIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdTeamsTeamIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrgsIdTeamsTeamIdMembersUserIdResponse parses an HTTP response from a DeleteOrgsIdTeamsTeamIdMembersUserIdWithResponse call
+func ParseDeleteOrgsIdTeamsTeamIdMembersUserIdResponse(rsp *http.Response) (*DeleteOrgsIdTeamsTeamIdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrgsIdTeamsTeamIdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdTeamsTeamIdRulesResponse parses an HTTP response from a PostOrgsIdTeamsTeamIdRulesWithResponse call
+func ParsePostOrgsIdTeamsTeamIdRulesResponse(rsp *http.Response) (*PostOrgsIdTeamsTeamIdRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdTeamsTeamIdRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrgsIdTeamsTeamIdRulesRuleResponse parses an HTTP response from a DeleteOrgsIdTeamsTeamIdRulesRuleWithResponse call
+func ParseDeleteOrgsIdTeamsTeamIdRulesRuleResponse(rsp *http.Response) (*DeleteOrgsIdTeamsTeamIdRulesRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrgsIdTeamsTeamIdRulesRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdUsageResponse parses an HTTP response from a GetOrgsIdUsageWithResponse call
+func ParseGetOrgsIdUsageResponse(rsp *http.Response) (*GetOrgsIdUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrgUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdWebhooksResponse parses an HTTP response from a GetOrgsIdWebhooksWithResponse call
+func ParseGetOrgsIdWebhooksResponse(rsp *http.Response) (*GetOrgsIdWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdWebhooksResponse parses an HTTP response from a PostOrgsIdWebhooksWithResponse call
+func ParsePostOrgsIdWebhooksResponse(rsp *http.Response) (*PostOrgsIdWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrgsIdWebhooksWebhookIdResponse parses an HTTP response from a DeleteOrgsIdWebhooksWebhookIdWithResponse call
+func ParseDeleteOrgsIdWebhooksWebhookIdResponse(rsp *http.Response) (*DeleteOrgsIdWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrgsIdWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdWebhooksWebhookIdResponse parses an HTTP response from a GetOrgsIdWebhooksWebhookIdWithResponse call
+func ParseGetOrgsIdWebhooksWebhookIdResponse(rsp *http.Response) (*GetOrgsIdWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutOrgsIdWebhooksWebhookIdResponse parses an HTTP response from a PutOrgsIdWebhooksWebhookIdWithResponse call
+func ParsePutOrgsIdWebhooksWebhookIdResponse(rsp *http.Response) (*PutOrgsIdWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutOrgsIdWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgsIdWebhooksWebhookIdDeliveriesResponse parses an HTTP response from a GetOrgsIdWebhooksWebhookIdDeliveriesWithResponse call
+func ParseGetOrgsIdWebhooksWebhookIdDeliveriesResponse(rsp *http.Response) (*GetOrgsIdWebhooksWebhookIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgsIdWebhooksWebhookIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrgsIdWebhooksWebhookIdTestResponse parses an HTTP response from a PostOrgsIdWebhooksWebhookIdTestWithResponse call
+func ParsePostOrgsIdWebhooksWebhookIdTestResponse(rsp *http.Response) (*PostOrgsIdWebhooksWebhookIdTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrgsIdWebhooksWebhookIdTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPaymentsProviderNotifyResponse parses an HTTP response from a PostPaymentsProviderNotifyWithResponse call
+func ParsePostPaymentsProviderNotifyResponse(rsp *http.Response) (*PostPaymentsProviderNotifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPaymentsProviderNotifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPlansResponse parses an HTTP response from a GetPlansWithResponse call
+func ParseGetPlansResponse(rsp *http.Response) (*GetPlansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPlansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Plan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /admin/scheduled-task-runs)
+	GetAdminScheduledTaskRuns(c *fiber.Ctx, params GetAdminScheduledTaskRunsParams) error
+
+	// (POST /auth/change-password)
+	PostAuthChangePassword(c *fiber.Ctx) error
+
+	// (POST /auth/code)
+	PostAuthCode(c *fiber.Ctx) error
+
+	// (POST /auth/delete-account)
+	PostAuthDeleteAccount(c *fiber.Ctx) error
+
+	// (POST /auth/login)
+	PostAuthLogin(c *fiber.Ctx) error
+
+	// (POST /auth/logout)
+	PostAuthLogout(c *fiber.Ctx) error
+
+	// (GET /auth/ping)
+	GetAuthPing(c *fiber.Ctx) error
+
+	// (POST /auth/refresh-token)
+	PostAuthRefreshToken(c *fiber.Ctx) error
+
+	// (POST /auth/register)
+	PostAuthRegister(c *fiber.Ctx) error
+
+	// (POST /dingtalk/callback)
+	PostDingtalkCallback(c *fiber.Ctx, params PostDingtalkCallbackParams) error
+
+	// (GET /orgs)
+	GetOrgs(c *fiber.Ctx) error
+
+	// (GET /orgs/{id}/members)
+	GetOrgsIdMembers(c *fiber.Ctx, id openapi_types.UUID, params GetOrgsIdMembersParams) error
+
+	// (POST /orgs/{id}/recharges)
+	PostOrgsIdRecharges(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (GET /orgs/{id}/recharges/{orderId})
+	GetOrgsIdRechargesOrderId(c *fiber.Ctx, id openapi_types.UUID, orderId openapi_types.UUID) error
+
+	// (GET /orgs/{id}/statements)
+	GetOrgsIdStatements(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (GET /orgs/{id}/statements/{month})
+	GetOrgsIdStatementsMonth(c *fiber.Ctx, id openapi_types.UUID, month string, params GetOrgsIdStatementsMonthParams) error
+
+	// (DELETE /orgs/{id}/subscription)
+	DeleteOrgsIdSubscription(c *fiber.Ctx, id openapi_types.UUID, params DeleteOrgsIdSubscriptionParams) error
+
+	// (GET /orgs/{id}/subscription)
+	GetOrgsIdSubscription(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (POST /orgs/{id}/subscription)
+	PostOrgsIdSubscription(c *fiber.Ctx, id openapi_types.UUID, params PostOrgsIdSubscriptionParams) error
+
+	// (GET /orgs/{id}/teams)
+	GetOrgsIdTeams(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (POST /orgs/{id}/teams)
+	PostOrgsIdTeams(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (DELETE /orgs/{id}/teams/{teamId})
+	DeleteOrgsIdTeamsTeamId(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID, params DeleteOrgsIdTeamsTeamIdParams) error
+
+	// (GET /orgs/{id}/teams/{teamId})
+	GetOrgsIdTeamsTeamId(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID) error
+
+	// (PUT /orgs/{id}/teams/{teamId})
+	PutOrgsIdTeamsTeamId(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID, params PutOrgsIdTeamsTeamIdParams) error
+
+	// (POST /orgs/{id}/teams/{teamId}/members)
+	PostOrgsIdTeamsTeamIdMembers(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID) error
+
+	// (DELETE /orgs/{id}/teams/{teamId}/members/{userId})
+	DeleteOrgsIdTeamsTeamIdMembersUserId(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID, userId openapi_types.UUID) error
+
+	// (POST /orgs/{id}/teams/{teamId}/rules)
+	PostOrgsIdTeamsTeamIdRules(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID) error
+
+	// (DELETE /orgs/{id}/teams/{teamId}/rules/{rule})
+	DeleteOrgsIdTeamsTeamIdRulesRule(c *fiber.Ctx, id openapi_types.UUID, teamId openapi_types.UUID, rule string) error
+
+	// (GET /orgs/{id}/usage)
+	GetOrgsIdUsage(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (GET /orgs/{id}/webhooks)
+	GetOrgsIdWebhooks(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (POST /orgs/{id}/webhooks)
+	PostOrgsIdWebhooks(c *fiber.Ctx, id openapi_types.UUID) error
+
+	// (DELETE /orgs/{id}/webhooks/{webhookId})
+	DeleteOrgsIdWebhooksWebhookId(c *fiber.Ctx, id openapi_types.UUID, webhookId openapi_types.UUID) error
+
+	// (GET /orgs/{id}/webhooks/{webhookId})
+	GetOrgsIdWebhooksWebhookId(c *fiber.Ctx, id openapi_types.UUID, webhookId openapi_types.UUID) error
+
+	// (PUT /orgs/{id}/webhooks/{webhookId})
+	PutOrgsIdWebhooksWebhookId(c *fiber.Ctx, id openapi_types.UUID, webhookId openapi_types.UUID) error
+
+	// (GET /orgs/{id}/webhooks/{webhookId}/deliveries)
+	GetOrgsIdWebhooksWebhookIdDeliveries(c *fiber.Ctx, id openapi_types.UUID, webhookId openapi_types.UUID, params GetOrgsIdWebhooksWebhookIdDeliveriesParams) error
+
+	// (POST /orgs/{id}/webhooks/{webhookId}/test)
+	PostOrgsIdWebhooksWebhookIdTest(c *fiber.Ctx, id openapi_types.UUID, webhookId openapi_types.UUID) error
+
+	// (POST /payments/{provider}/notify)
+	PostPaymentsProviderNotify(c *fiber.Ctx, provider string) error
+
+	// (GET /plans)
+	GetPlans(c *fiber.Ctx) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// GetAdminScheduledTaskRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminScheduledTaskRuns(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminScheduledTaskRunsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	// ------------- Optional query parameter "task" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "task", query, &params.Task)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter task: %w", err).Error())
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostAuthChangePasswordWithBodyWithResponse request with any body
-	PostAuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error)
+	// ------------- Optional query parameter "limit" -------------
 
-	PostAuthChangePasswordWithResponse(ctx context.Context, body PostAuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-	// PostAuthCodeWithBodyWithResponse request with any body
-	PostAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error)
+	// ------------- Optional query parameter "cursor" -------------
 
-	PostAuthCodeWithResponse(ctx context.Context, body PostAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
 
-	// PostAuthLoginWithBodyWithResponse request with any body
-	PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+	// ------------- Optional query parameter "sort" -------------
 
-	PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
 
-	// PostAuthLogoutWithResponse request
-	PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+	return siw.Handler.GetAdminScheduledTaskRuns(c, params)
+}
 
-	// GetAuthPingWithResponse request
-	GetAuthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthPingResponse, error)
+// PostAuthChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthChangePassword(c *fiber.Ctx) error {
 
-	// PostAuthRefreshTokenWithBodyWithResponse request with any body
-	PostAuthRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error)
+	return siw.Handler.PostAuthChangePassword(c)
+}
 
-	PostAuthRefreshTokenWithResponse(ctx context.Context, body PostAuthRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error)
+// PostAuthCode operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthCode(c *fiber.Ctx) error {
 
-	// PostAuthRegisterWithBodyWithResponse request with any body
-	PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+	return siw.Handler.PostAuthCode(c)
+}
 
-	PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+// PostAuthDeleteAccount operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthDeleteAccount(c *fiber.Ctx) error {
 
-	// GetOrgsWithResponse request
-	GetOrgsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgsResponse, error)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostAuthDeleteAccount(c)
 }
 
-type PostAuthChangePasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthLogin(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthChangePasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostAuthLogout(c)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthChangePasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// GetAuthPing operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthPing(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAuthPing(c)
 }
 
-type PostAuthCodeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostAuthRefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefreshToken(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthRefreshToken(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthCodeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// PostAuthRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRegister(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthRegister(c)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthCodeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostDingtalkCallback operation middleware
+func (siw *ServerInterfaceWrapper) PostDingtalkCallback(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDingtalkCallbackParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return 0
-}
 
-type PostAuthLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthInfo
-}
+	// ------------- Required query parameter "signature" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostAuthLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, true, "signature", query, &params.Signature)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter signature: %w", err).Error())
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Required query parameter "timestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "timestamp", query, &params.Timestamp)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter timestamp: %w", err).Error())
 	}
-	return 0
+
+	// ------------- Required query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "nonce", query, &params.Nonce)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter nonce: %w", err).Error())
+	}
+
+	return siw.Handler.PostDingtalkCallback(c, params)
 }
 
-type PostAuthLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetOrgs operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgs(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgs(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetOrgsIdMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdMembers(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrgsIdMembersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return 0
-}
 
-type GetAuthPingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetAuthPingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
-	return http.StatusText(0)
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "keyword" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrgsIdMembers(c, id, params)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAuthPingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostOrgsIdRecharges operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdRecharges(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return 0
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrgsIdRecharges(c, id)
 }
 
-type PostAuthRefreshTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		RefreshToken string `json:"refreshToken"`
-		Token        string `json:"token"`
+// GetOrgsIdRechargesOrderId operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdRechargesOrderId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", c.Params("orderId"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter orderId: %w", err).Error())
 	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdRechargesOrderId(c, id, orderId)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthRefreshTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetOrgsIdStatements operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdStatements(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return http.StatusText(0)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdStatements(c, id)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthRefreshTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetOrgsIdStatementsMonth operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdStatementsMonth(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return 0
-}
 
-type PostAuthRegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	// ------------- Path parameter "month" -------------
+	var month string
 
-// Status returns HTTPResponse.Status
-func (r PostAuthRegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Params("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter month: %w", err).Error())
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthRegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrgsIdStatementsMonthParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return 0
-}
 
-type GetOrgsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrgInfoRes
-}
+	// ------------- Optional query parameter "format" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetOrgsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
 	}
-	return http.StatusText(0)
+
+	return siw.Handler.GetOrgsIdStatementsMonth(c, id, month, params)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrgsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteOrgsIdSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrgsIdSubscription(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return 0
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteOrgsIdSubscriptionParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var ifMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value[0], &ifMatch, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &ifMatch
+
+	}
+
+	return siw.Handler.DeleteOrgsIdSubscription(c, id, params)
 }
 
-// PostAuthChangePasswordWithBodyWithResponse request with arbitrary body returning *PostAuthChangePasswordResponse
-func (c *ClientWithResponses) PostAuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error) {
-	rsp, err := c.PostAuthChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+// GetOrgsIdSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdSubscription(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthChangePasswordResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdSubscription(c, id)
 }
 
-func (c *ClientWithResponses) PostAuthChangePasswordWithResponse(ctx context.Context, body PostAuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthChangePasswordResponse, error) {
-	rsp, err := c.PostAuthChangePassword(ctx, body, reqEditors...)
+// PostOrgsIdSubscription operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdSubscription(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthChangePasswordResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostOrgsIdSubscriptionParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var ifMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value[0], &ifMatch, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &ifMatch
+
+	}
+
+	return siw.Handler.PostOrgsIdSubscription(c, id, params)
 }
 
-// PostAuthCodeWithBodyWithResponse request with arbitrary body returning *PostAuthCodeResponse
-func (c *ClientWithResponses) PostAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error) {
-	rsp, err := c.PostAuthCodeWithBody(ctx, contentType, body, reqEditors...)
+// GetOrgsIdTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdTeams(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthCodeResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdTeams(c, id)
 }
 
-func (c *ClientWithResponses) PostAuthCodeWithResponse(ctx context.Context, body PostAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthCodeResponse, error) {
-	rsp, err := c.PostAuthCode(ctx, body, reqEditors...)
+// PostOrgsIdTeams operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdTeams(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthCodeResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrgsIdTeams(c, id)
 }
 
-// PostAuthLoginWithBodyWithResponse request with arbitrary body returning *PostAuthLoginResponse
-func (c *ClientWithResponses) PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
-	rsp, err := c.PostAuthLoginWithBody(ctx, contentType, body, reqEditors...)
+// DeleteOrgsIdTeamsTeamId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrgsIdTeamsTeamId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
-	rsp, err := c.PostAuthLogin(ctx, body, reqEditors...)
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
-	return ParsePostAuthLoginResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteOrgsIdTeamsTeamIdParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var ifMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value[0], &ifMatch, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &ifMatch
+
+	}
+
+	return siw.Handler.DeleteOrgsIdTeamsTeamId(c, id, teamId, params)
 }
 
-// PostAuthLogoutWithResponse request returning *PostAuthLogoutResponse
-func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
-	rsp, err := c.PostAuthLogout(ctx, reqEditors...)
+// GetOrgsIdTeamsTeamId operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdTeamsTeamId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthLogoutResponse(rsp)
-}
 
-// GetAuthPingWithResponse request returning *GetAuthPingResponse
-func (c *ClientWithResponses) GetAuthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthPingResponse, error) {
-	rsp, err := c.GetAuthPing(ctx, reqEditors...)
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
-	return ParseGetAuthPingResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdTeamsTeamId(c, id, teamId)
 }
 
-// PostAuthRefreshTokenWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshTokenResponse
-func (c *ClientWithResponses) PostAuthRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error) {
-	rsp, err := c.PostAuthRefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+// PutOrgsIdTeamsTeamId operation middleware
+func (siw *ServerInterfaceWrapper) PutOrgsIdTeamsTeamId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthRefreshTokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostAuthRefreshTokenWithResponse(ctx context.Context, body PostAuthRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshTokenResponse, error) {
-	rsp, err := c.PostAuthRefreshToken(ctx, body, reqEditors...)
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
-	return ParsePostAuthRefreshTokenResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutOrgsIdTeamsTeamIdParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var ifMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value[0], &ifMatch, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &ifMatch
+
+	}
+
+	return siw.Handler.PutOrgsIdTeamsTeamId(c, id, teamId, params)
 }
 
-// PostAuthRegisterWithBodyWithResponse request with arbitrary body returning *PostAuthRegisterResponse
-func (c *ClientWithResponses) PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
-	rsp, err := c.PostAuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+// PostOrgsIdTeamsTeamIdMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdTeamsTeamIdMembers(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParsePostAuthRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
-	rsp, err := c.PostAuthRegister(ctx, body, reqEditors...)
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
-	return ParsePostAuthRegisterResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrgsIdTeamsTeamIdMembers(c, id, teamId)
 }
 
-// GetOrgsWithResponse request returning *GetOrgsResponse
-func (c *ClientWithResponses) GetOrgsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgsResponse, error) {
-	rsp, err := c.GetOrgs(ctx, reqEditors...)
+// DeleteOrgsIdTeamsTeamIdMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrgsIdTeamsTeamIdMembersUserId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	return ParseGetOrgsResponse(rsp)
-}
 
-// ParsePostAuthChangePasswordResponse parses an HTTP response from a PostAuthChangePasswordWithResponse call
-func ParsePostAuthChangePasswordResponse(rsp *http.Response) (*PostAuthChangePasswordResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
 
-	response := &PostAuthChangePasswordResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Params("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter userId: %w", err).Error())
 	}
 
-	return response, nil
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteOrgsIdTeamsTeamIdMembersUserId(c, id, teamId, userId)
+}
+
+// PostOrgsIdTeamsTeamIdRules operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdTeamsTeamIdRules(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParsePostAuthCodeResponse parses an HTTP response from a PostAuthCodeWithResponse call
-func ParsePostAuthCodeResponse(rsp *http.Response) (*PostAuthCodeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &PostAuthCodeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrgsIdTeamsTeamIdRules(c, id, teamId)
 }
 
-// ParsePostAuthLoginResponse parses an HTTP response from a PostAuthLoginWithResponse call
-func ParsePostAuthLoginResponse(rsp *http.Response) (*PostAuthLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteOrgsIdTeamsTeamIdRulesRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrgsIdTeamsTeamIdRulesRule(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &PostAuthLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "teamId" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamId", c.Params("teamId"), &teamId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "rule" -------------
+	var rule string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "rule", c.Params("rule"), &rule, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rule: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteOrgsIdTeamsTeamIdRulesRule(c, id, teamId, rule)
 }
 
-// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
-func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetOrgsIdUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdUsage(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &PostAuthLogoutResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
+	return siw.Handler.GetOrgsIdUsage(c, id)
 }
 
-// ParseGetAuthPingResponse parses an HTTP response from a GetAuthPingWithResponse call
-func ParseGetAuthPingResponse(rsp *http.Response) (*GetAuthPingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetOrgsIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdWebhooks(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &GetAuthPingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
+	return siw.Handler.GetOrgsIdWebhooks(c, id)
 }
 
-// ParsePostAuthRefreshTokenResponse parses an HTTP response from a PostAuthRefreshTokenWithResponse call
-func ParsePostAuthRefreshTokenResponse(rsp *http.Response) (*PostAuthRefreshTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostOrgsIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdWebhooks(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &PostAuthRefreshTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrgsIdWebhooks(c, id)
+}
+
+// DeleteOrgsIdWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrgsIdWebhooksWebhookId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			RefreshToken string `json:"refreshToken"`
-			Token        string `json:"token"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Params("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter webhookId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteOrgsIdWebhooksWebhookId(c, id, webhookId)
 }
 
-// ParsePostAuthRegisterResponse parses an HTTP response from a PostAuthRegisterWithResponse call
-func ParsePostAuthRegisterResponse(rsp *http.Response) (*PostAuthRegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetOrgsIdWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdWebhooksWebhookId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	response := &PostAuthRegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Params("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter webhookId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgsIdWebhooksWebhookId(c, id, webhookId)
 }
 
-// ParseGetOrgsResponse parses an HTTP response from a GetOrgsWithResponse call
-func ParseGetOrgsResponse(rsp *http.Response) (*GetOrgsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PutOrgsIdWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) PutOrgsIdWebhooksWebhookId(c *fiber.Ctx) error {
 
-	response := &GetOrgsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrgInfoRes
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Params("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter webhookId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutOrgsIdWebhooksWebhookId(c, id, webhookId)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+// GetOrgsIdWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgsIdWebhooksWebhookIdDeliveries(c *fiber.Ctx) error {
 
-	// (POST /auth/change-password)
-	PostAuthChangePassword(c *fiber.Ctx) error
+	var err error
 
-	// (POST /auth/code)
-	PostAuthCode(c *fiber.Ctx) error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	// (POST /auth/login)
-	PostAuthLogin(c *fiber.Ctx) error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	// (POST /auth/logout)
-	PostAuthLogout(c *fiber.Ctx) error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	// (GET /auth/ping)
-	GetAuthPing(c *fiber.Ctx) error
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Params("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter webhookId: %w", err).Error())
+	}
 
-	// (POST /auth/refresh-token)
-	PostAuthRefreshToken(c *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// (POST /auth/register)
-	PostAuthRegister(c *fiber.Ctx) error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrgsIdWebhooksWebhookIdDeliveriesParams
 
-	// (GET /orgs)
-	GetOrgs(c *fiber.Ctx) error
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type MiddlewareFunc fiber.Handler
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-// PostAuthChangePassword operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthChangePassword(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "cursor" -------------
 
-	return siw.Handler.PostAuthChangePassword(c)
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
 
-// PostAuthCode operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthCode(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "sort" -------------
 
-	return siw.Handler.PostAuthCode(c)
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrgsIdWebhooksWebhookIdDeliveries(c, id, webhookId, params)
 }
 
-// PostAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogin(c *fiber.Ctx) error {
+// PostOrgsIdWebhooksWebhookIdTest operation middleware
+func (siw *ServerInterfaceWrapper) PostOrgsIdWebhooksWebhookIdTest(c *fiber.Ctx) error {
 
-	return siw.Handler.PostAuthLogin(c)
-}
+	var err error
 
-// PostAuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogout(c *fiber.Ctx) error {
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return siw.Handler.PostAuthLogout(c)
-}
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-// GetAuthPing operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthPing(c *fiber.Ctx) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Params("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter webhookId: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetAuthPing(c)
+	return siw.Handler.PostOrgsIdWebhooksWebhookIdTest(c, id, webhookId)
 }
 
-// PostAuthRefreshToken operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRefreshToken(c *fiber.Ctx) error {
+// PostPaymentsProviderNotify operation middleware
+func (siw *ServerInterfaceWrapper) PostPaymentsProviderNotify(c *fiber.Ctx) error {
 
-	return siw.Handler.PostAuthRefreshToken(c)
-}
+	var err error
 
-// PostAuthRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRegister(c *fiber.Ctx) error {
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-	return siw.Handler.PostAuthRegister(c)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Params("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter provider: %w", err).Error())
+	}
 
-// GetOrgs operation middleware
-func (siw *ServerInterfaceWrapper) GetOrgs(c *fiber.Ctx) error {
+	return siw.Handler.PostPaymentsProviderNotify(c, provider)
+}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+// GetPlans operation middleware
+func (siw *ServerInterfaceWrapper) GetPlans(c *fiber.Ctx) error {
 
-	return siw.Handler.GetOrgs(c)
+	return siw.Handler.GetPlans(c)
 }
 
 // FiberServerOptions provides options for the Fiber server.
@@ -1281,10 +6535,14 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 		router.Use(m)
 	}
 
+	router.Get(options.BaseURL+"/admin/scheduled-task-runs", wrapper.GetAdminScheduledTaskRuns)
+
 	router.Post(options.BaseURL+"/auth/change-password", wrapper.PostAuthChangePassword)
 
 	router.Post(options.BaseURL+"/auth/code", wrapper.PostAuthCode)
 
+	router.Post(options.BaseURL+"/auth/delete-account", wrapper.PostAuthDeleteAccount)
+
 	router.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
 
 	router.Post(options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
@@ -1295,6 +6553,62 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Post(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
 
+	router.Post(options.BaseURL+"/dingtalk/callback", wrapper.PostDingtalkCallback)
+
 	router.Get(options.BaseURL+"/orgs", wrapper.GetOrgs)
 
+	router.Get(options.BaseURL+"/orgs/:id/members", wrapper.GetOrgsIdMembers)
+
+	router.Post(options.BaseURL+"/orgs/:id/recharges", wrapper.PostOrgsIdRecharges)
+
+	router.Get(options.BaseURL+"/orgs/:id/recharges/:orderId", wrapper.GetOrgsIdRechargesOrderId)
+
+	router.Get(options.BaseURL+"/orgs/:id/statements", wrapper.GetOrgsIdStatements)
+
+	router.Get(options.BaseURL+"/orgs/:id/statements/:month", wrapper.GetOrgsIdStatementsMonth)
+
+	router.Delete(options.BaseURL+"/orgs/:id/subscription", wrapper.DeleteOrgsIdSubscription)
+
+	router.Get(options.BaseURL+"/orgs/:id/subscription", wrapper.GetOrgsIdSubscription)
+
+	router.Post(options.BaseURL+"/orgs/:id/subscription", wrapper.PostOrgsIdSubscription)
+
+	router.Get(options.BaseURL+"/orgs/:id/teams", wrapper.GetOrgsIdTeams)
+
+	router.Post(options.BaseURL+"/orgs/:id/teams", wrapper.PostOrgsIdTeams)
+
+	router.Delete(options.BaseURL+"/orgs/:id/teams/:teamId", wrapper.DeleteOrgsIdTeamsTeamId)
+
+	router.Get(options.BaseURL+"/orgs/:id/teams/:teamId", wrapper.GetOrgsIdTeamsTeamId)
+
+	router.Put(options.BaseURL+"/orgs/:id/teams/:teamId", wrapper.PutOrgsIdTeamsTeamId)
+
+	router.Post(options.BaseURL+"/orgs/:id/teams/:teamId/members", wrapper.PostOrgsIdTeamsTeamIdMembers)
+
+	router.Delete(options.BaseURL+"/orgs/:id/teams/:teamId/members/:userId", wrapper.DeleteOrgsIdTeamsTeamIdMembersUserId)
+
+	router.Post(options.BaseURL+"/orgs/:id/teams/:teamId/rules", wrapper.PostOrgsIdTeamsTeamIdRules)
+
+	router.Delete(options.BaseURL+"/orgs/:id/teams/:teamId/rules/:rule", wrapper.DeleteOrgsIdTeamsTeamIdRulesRule)
+
+	router.Get(options.BaseURL+"/orgs/:id/usage", wrapper.GetOrgsIdUsage)
+
+	router.Get(options.BaseURL+"/orgs/:id/webhooks", wrapper.GetOrgsIdWebhooks)
+
+	router.Post(options.BaseURL+"/orgs/:id/webhooks", wrapper.PostOrgsIdWebhooks)
+
+	router.Delete(options.BaseURL+"/orgs/:id/webhooks/:webhookId", wrapper.DeleteOrgsIdWebhooksWebhookId)
+
+	router.Get(options.BaseURL+"/orgs/:id/webhooks/:webhookId", wrapper.GetOrgsIdWebhooksWebhookId)
+
+	router.Put(options.BaseURL+"/orgs/:id/webhooks/:webhookId", wrapper.PutOrgsIdWebhooksWebhookId)
+
+	router.Get(options.BaseURL+"/orgs/:id/webhooks/:webhookId/deliveries", wrapper.GetOrgsIdWebhooksWebhookIdDeliveries)
+
+	router.Post(options.BaseURL+"/orgs/:id/webhooks/:webhookId/test", wrapper.PostOrgsIdWebhooksWebhookIdTest)
+
+	router.Post(options.BaseURL+"/payments/:provider/notify", wrapper.PostPaymentsProviderNotify)
+
+	router.Get(options.BaseURL+"/plans", wrapper.GetPlans)
+
 }
@@ -0,0 +1,98 @@
+package apps
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/xich-dev/go-starter/pkg/apps/server"
+	"github.com/xich-dev/go-starter/pkg/apps/worker"
+	"github.com/xich-dev/go-starter/pkg/logger"
+)
+
+var log = logger.NewLogAgent("apps")
+
+// stopTimeout bounds the wait for an app to return from Listen once it is shut down, an
+// app failing before its sibling has started listening may leave it running.
+const stopTimeout = 5 * time.Second
+
+// runner is the server or the worker.
+type runner interface {
+	Listen() error
+	Shutdown() error
+}
+
+// All runs the server and the worker in one process, sharing the config, the model
+// and the providers.
+type All struct {
+	server *server.Server
+	worker *worker.Worker
+
+	shuttingDown atomic.Bool
+}
+
+func NewAll(s *server.Server, w *worker.Worker) *All {
+	return &All{server: s, worker: w}
+}
+
+type listenResult struct {
+	name string
+	err  error
+}
+
+// Listen runs both apps until both of them stop. If one of them fails, the other one is
+// shut down before returning, as the database connections are closed afterwards.
+func (a *All) Listen() error {
+	return a.listen(map[string]runner{"server": a.server, "worker": a.worker})
+}
+
+func (a *All) listen(runners map[string]runner) error {
+	results := make(chan listenResult, len(runners))
+	for name, r := range runners {
+		go func(name string, r runner) {
+			results <- listenResult{name: name, err: r.Listen()}
+		}(name, r)
+	}
+
+	first := <-results
+	if a.shuttingDown.Load() {
+		// Shutdown stops the other apps
+		for i := 1; i < len(runners); i++ {
+			if r := <-results; first.err == nil {
+				first = r
+			}
+		}
+		return first.err
+	}
+
+	if first.err != nil {
+		log.Errorf("%s failed, shutting down the other apps: %s", first.name, first.err.Error())
+	}
+	for name, r := range runners {
+		if name == first.name {
+			continue
+		}
+		if err := r.Shutdown(); err != nil {
+			log.Errorf("failed to shut down %s: %s", name, err.Error())
+		}
+	}
+	for i := 1; i < len(runners); i++ {
+		select {
+		case <-results:
+		case <-time.After(stopTimeout):
+			log.Warnf("apps are still running %s after shutdown", stopTimeout)
+			return first.err
+		}
+	}
+	return first.err
+}
+
+// Shutdown drains the server before stopping the worker, as the in-flight requests may
+// still queue background work.
+func (a *All) Shutdown() error {
+	a.shuttingDown.Store(true)
+	serverErr := a.server.Shutdown()
+	if err := a.worker.Shutdown(); err != nil {
+		return err
+	}
+	return serverErr
+}
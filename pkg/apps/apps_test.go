@@ -0,0 +1,93 @@
+package apps
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRunner listens until it fails or is shut down.
+type fakeRunner struct {
+	fail     chan error
+	stop     chan struct{}
+	shutdown atomic.Int32
+}
+
+func newFakeRunner() *fakeRunner {
+	return &fakeRunner{fail: make(chan error, 1), stop: make(chan struct{})}
+}
+
+func (r *fakeRunner) Listen() error {
+	select {
+	case err := <-r.fail:
+		return err
+	case <-r.stop:
+		return nil
+	}
+}
+
+func (r *fakeRunner) Shutdown() error {
+	if r.shutdown.Add(1) == 1 {
+		close(r.stop)
+	}
+	return nil
+}
+
+func listenAsync(a *All, runners map[string]runner) chan error {
+	errc := make(chan error, 1)
+	go func() {
+		errc <- a.listen(runners)
+	}()
+	return errc
+}
+
+func TestListenShutsDownSibling(t *testing.T) {
+	var (
+		a      = &All{}
+		server = newFakeRunner()
+		worker = newFakeRunner()
+	)
+	errc := listenAsync(a, map[string]runner{"server": server, "worker": worker})
+
+	server.fail <- errors.New("failed to seed")
+	select {
+	case err := <-errc:
+		require.EqualError(t, err, "failed to seed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("listen does not return after the server failed")
+	}
+	// the worker is stopped before the database connections are closed
+	assert.Equal(t, int32(1), worker.shutdown.Load())
+	assert.Equal(t, int32(0), server.shutdown.Load())
+}
+
+func TestListenAfterShutdown(t *testing.T) {
+	var (
+		a      = &All{}
+		server = newFakeRunner()
+		worker = newFakeRunner()
+	)
+	errc := listenAsync(a, map[string]runner{"server": server, "worker": worker})
+
+	// the apps are shut down in order by Shutdown, not by Listen
+	a.shuttingDown.Store(true)
+	require.NoError(t, server.Shutdown())
+	select {
+	case <-errc:
+		t.Fatal("listen returns before the worker stopped")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, worker.Shutdown())
+	select {
+	case err := <-errc:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listen does not return after shutdown")
+	}
+	assert.Equal(t, int32(1), server.shutdown.Load())
+	assert.Equal(t, int32(1), worker.shutdown.Load())
+}
@@ -1,38 +1,58 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/pkg/errors"
 	"github.com/xich-dev/go-starter/pkg/apigen"
 	"github.com/xich-dev/go-starter/pkg/config"
 	"github.com/xich-dev/go-starter/pkg/controller"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/logger"
 	"github.com/xich-dev/go-starter/pkg/middleware"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/service"
 )
 
+var log = logger.NewLogAgent("server")
+
 type Server struct {
-	app        *fiber.App
-	port       int
-	middleware *middleware.Middleware
-	controller *controller.Controller
+	app             *fiber.App
+	port            int
+	shutdownTimeout time.Duration
+	drainDelay      time.Duration
+	seed            config.Seed
+	middleware      *middleware.Middleware
+	controller      *controller.Controller
+	health          *health.Registry
 }
 
-func NewServer(cfg *config.Config, c *controller.Controller, middleware *middleware.Middleware) *Server {
+func NewServer(cfg *config.Config, c *controller.Controller, middleware *middleware.Middleware, h *health.Registry) *Server {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: middleware.ErrorHandler,
 		BodyLimit:    50 * 1024 * 1024, // 50MB
 	})
 
 	s := &Server{
-		app:        app,
-		port:       cfg.Port,
-		middleware: middleware,
-		controller: c,
+		app:             app,
+		port:            cfg.Port,
+		shutdownTimeout: cfg.ShutdownTimeout,
+		drainDelay:      cfg.ShutdownDrainDelay,
+		seed:            cfg.Seed,
+		middleware:      middleware,
+		controller:      c,
+		health:          h,
 	}
 
+	s.controller.GetService().RegisterHealthChecks(s.health)
 	s.registerMiddleware()
 
 	apigen.RegisterHandlersWithOptions(s.app, s.controller, apigen.FiberServerOptions{
@@ -51,19 +71,57 @@ func (s *Server) registerMiddleware() {
 	s.app.Use(recover.New(recover.Config{
 		EnableStackTrace: true,
 	}))
+	// the probes are registered before the logger to keep them out of the access log
+	s.app.Get("/healthz", s.health.Liveness)
+	s.app.Get("/readyz", s.health.Readiness)
 	s.app.Use(cors.New(cors.Config{}))
 	s.app.Use(requestid.New())
 	s.app.Use(middleware.NewLogger())
 
-	s.app.Get("/api/v1/auth/ping", s.middleware.Auth())
-	s.app.Get("/api/v1/orgs", s.middleware.Auth())
+	svc := s.controller.GetService()
+	s.app.Get("/api/v1/auth/ping", s.middleware.Auth(svc))
+	s.app.Post("/api/v1/auth/delete-account", s.middleware.Auth(svc))
+	s.app.Get("/api/v1/orgs", s.middleware.Auth(svc))
+	s.app.Use("/api/v1/orgs/:id", s.middleware.Auth(svc), s.middleware.Quota(svc, service.UsageMetricApiCall, isBillingRoute))
+	s.app.Use("/api/v1/admin", s.middleware.Auth(svc), s.middleware.CheckRules([]string{model.RuleAdmin}, nil))
+
+}
+
+// billingResources are the resources of an org which are not metered as API calls, so
+// that an org which has used up its quota can still pay to raise it.
+var billingResources = []string{"recharges", "subscription", "usage", "statements"}
 
+func isBillingRoute(c *fiber.Ctx) bool {
+	// /api/v1/orgs/:id/<resource>/...
+	parts := strings.SplitN(strings.TrimPrefix(c.Path(), "/api/v1/orgs/"), "/", 3)
+	return len(parts) >= 2 && slices.Contains(billingResources, parts[1])
 }
 
 func (s *Server) Listen() error {
+	if s.seed.OnStartup {
+		fixtures, err := service.LoadSeedFixtures(&s.seed, "")
+		if err != nil {
+			return err
+		}
+		if err := s.controller.GetService().Seed(context.Background(), fixtures); err != nil {
+			return errors.Wrap(err, "failed to seed")
+		}
+	}
 	return s.app.Listen(fmt.Sprintf(":%d", s.port))
 }
 
+// Shutdown fails the readiness for the drain delay, then stops accepting connections and
+// waits for the in-flight requests to finish within the shutdown timeout, Listen returns
+// once it is done.
+func (s *Server) Shutdown() error {
+	s.health.Drain(s.drainDelay)
+	log.Infof("draining in-flight requests, waiting up to %s", s.shutdownTimeout)
+	if err := s.app.ShutdownWithTimeout(s.shutdownTimeout); err != nil {
+		return errors.Wrap(err, "failed to shut down server")
+	}
+	return nil
+}
+
 func (s *Server) GetApp() *fiber.App {
 	return s.app
 }
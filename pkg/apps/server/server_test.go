@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBillingRoute(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "/api/v1/orgs/1/recharges", expected: true},
+		{path: "/api/v1/orgs/1/recharges/2", expected: true},
+		{path: "/api/v1/orgs/1/subscription", expected: true},
+		{path: "/api/v1/orgs/1/subscription/cancel", expected: true},
+		{path: "/api/v1/orgs/1/usage", expected: true},
+		{path: "/api/v1/orgs/1/statements/2024-03", expected: true},
+		{path: "/api/v1/orgs/1", expected: false},
+		{path: "/api/v1/orgs/1/teams", expected: false},
+		{path: "/api/v1/orgs/1/webhooks/recharges", expected: false},
+	}
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if isBillingRoute(c) {
+			return c.SendStatus(fiber.StatusNoContent)
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+	for _, testCase := range testCases {
+		resp, err := app.Test(httptest.NewRequest("GET", testCase.path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, resp.StatusCode == fiber.StatusNoContent, testCase.path)
+	}
+}
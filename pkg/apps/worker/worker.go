@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
+	"github.com/xich-dev/go-starter/pkg/logger"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+var log = logger.NewLogAgent("worker")
+
+// Worker is the app which runs the background work: it dispatches the outbox events,
+// runs the queued jobs and the scheduled tasks. It serves nothing but its health
+// endpoints, so it can be scaled apart from the server.
+type Worker struct {
+	app             *fiber.App
+	port            int
+	shutdownTimeout time.Duration
+	drainDelay      time.Duration
+	dispatcher      *outbox.Dispatcher
+	jobWorker       *jobs.Worker
+	scheduler       *scheduler.Scheduler
+	health          *health.Registry
+
+	// ctx is cancelled on shutdown to stop the background work
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewWorker(cfg *config.Config, svc service.ServiceInterface, dispatcher *outbox.Dispatcher, jobWorker *jobs.Worker, scheduler *scheduler.Scheduler, h *health.Registry) *Worker {
+	app := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Worker{
+		app:             app,
+		port:            cfg.Worker.Port,
+		shutdownTimeout: cfg.ShutdownTimeout,
+		drainDelay:      cfg.ShutdownDrainDelay,
+		dispatcher:      dispatcher,
+		jobWorker:       jobWorker,
+		scheduler:       scheduler,
+		health:          h,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+
+	svc.RegisterEventHandlers(w.dispatcher)
+	svc.RegisterJobHandlers(w.jobWorker)
+	svc.RegisterScheduledTasks(w.scheduler)
+	svc.RegisterHealthChecks(w.health)
+
+	w.app.Use(recover.New())
+	w.app.Get("/healthz", w.health.Liveness)
+	w.app.Get("/readyz", w.health.Readiness)
+
+	return w
+}
+
+// Listen starts the background work and serves the health endpoints.
+func (w *Worker) Listen() error {
+	for _, run := range []func(context.Context){
+		w.dispatcher.Run,
+		w.jobWorker.Run,
+		w.scheduler.Run,
+	} {
+		w.wg.Add(1)
+		go func(run func(context.Context)) {
+			defer w.wg.Done()
+			run(w.ctx)
+		}(run)
+	}
+	log.Infof("health endpoints listening on :%d", w.port)
+	return w.app.Listen(fmt.Sprintf(":%d", w.port))
+}
+
+// Shutdown fails the readiness, stops taking new work and waits for the running events,
+// jobs and tasks to finish within the shutdown timeout, then stops the health endpoint
+// once the readiness has failed for the drain delay. The jobs still running after the
+// timeout are retried once they are rescued.
+func (w *Worker) Shutdown() error {
+	w.health.SetShuttingDown()
+	log.Infof("stopping background work, waiting up to %s", w.shutdownTimeout)
+	w.cancel()
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(w.shutdownTimeout):
+		log.Warnf("background work is not finished in %s", w.shutdownTimeout)
+	}
+	w.health.Drain(w.drainDelay)
+	if err := w.app.ShutdownWithTimeout(w.shutdownTimeout); err != nil {
+		return errors.Wrap(err, "failed to shut down health endpoints")
+	}
+	return nil
+}
+
+func (w *Worker) GetApp() *fiber.App {
+	return w.app
+}
@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+// fakeService records the registrations of the worker.
+type fakeService struct {
+	service.ServiceInterface
+	registered []string
+}
+
+func (s *fakeService) RegisterEventHandlers(d *outbox.Dispatcher) {
+	s.registered = append(s.registered, "events")
+}
+
+func (s *fakeService) RegisterJobHandlers(w *jobs.Worker) {
+	s.registered = append(s.registered, "jobs")
+}
+
+func (s *fakeService) RegisterScheduledTasks(sc *scheduler.Scheduler) {
+	s.registered = append(s.registered, "tasks")
+}
+
+func (s *fakeService) RegisterHealthChecks(r *health.Registry) {
+	s.registered = append(s.registered, "health")
+	r.Register("database", func(ctx context.Context) error {
+		return nil
+	})
+}
+
+func newTestWorker(t *testing.T, m model.ModelInterface) (*Worker, *fakeService) {
+	cfg := &config.Config{
+		ShutdownTimeout: 5 * time.Second,
+		Worker: config.Worker{
+			Concurrency:  2,
+			PollInterval: 10 * time.Millisecond,
+			JobTimeout:   time.Minute,
+		},
+		Scheduler: config.Scheduler{
+			Timezone: "Asia/Shanghai",
+		},
+	}
+	sc, err := scheduler.NewScheduler(cfg, m)
+	require.NoError(t, err)
+	svc := &fakeService{}
+	w := NewWorker(cfg, svc, outbox.NewDispatcher(m), jobs.NewWorker(cfg, m), sc, health.NewRegistry())
+	return w, svc
+}
+
+func TestNewWorker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	w, svc := newTestWorker(t, model.NewMockModelInterface(ctrl))
+
+	assert.Equal(t, []string{"events", "jobs", "tasks", "health"}, svc.registered)
+
+	for _, path := range []string{"/healthz", "/readyz"} {
+		resp, err := w.GetApp().Test(httptest.NewRequest("GET", path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, path)
+	}
+}
+
+func TestWorkerListenAndShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := model.NewExtendedMockModelInterface(ctrl)
+
+	var claimed atomic.Int32
+	m.EXPECT().Listen(gomock.Any(), outbox.Channel).Return(nil, errors.New("listen is not supported")).AnyTimes()
+	m.EXPECT().ClaimNextOutboxEvent(gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows).AnyTimes()
+	m.EXPECT().ClaimNextJob(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, now time.Time) (*querier.Job, error) {
+		claimed.Add(1)
+		return nil, pgx.ErrNoRows
+	}).AnyTimes()
+
+	w, _ := newTestWorker(t, m)
+
+	listening := make(chan struct{})
+	w.GetApp().Hooks().OnListen(func(fiber.ListenData) error {
+		close(listening)
+		return nil
+	})
+	errc := make(chan error, 1)
+	go func() {
+		errc <- w.Listen()
+	}()
+	select {
+	case <-listening:
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker is not listening")
+	}
+
+	// the job workers are polling the queue
+	require.Eventually(t, func() bool {
+		return claimed.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, w.Shutdown())
+	select {
+	case err := <-errc:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listen does not return after shutdown")
+	}
+
+	// the background work has stopped
+	stopped := claimed.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, claimed.Load())
+
+	resp, err := w.GetApp().Test(httptest.NewRequest("GET", "/readyz", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,152 @@
+package dingtalk
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// the access token is refreshed a bit earlier than it expires
+const accessTokenLeeway = 5 * time.Minute
+
+type apiResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (r *apiResponse) err() error {
+	if r.ErrCode != 0 {
+		return errors.Errorf("dingtalk api error %d: %s", r.ErrCode, r.ErrMsg)
+	}
+	return nil
+}
+
+type textMessage struct {
+	MsgType string `json:"msgtype"`
+	Text    struct {
+		Content string `json:"content"`
+	} `json:"text"`
+	At *struct {
+		AtMobiles []string `json:"atMobiles"`
+	} `json:"at,omitempty"`
+}
+
+func newTextMessage(content string) textMessage {
+	msg := textMessage{MsgType: "text"}
+	msg.Text.Content = content
+	return msg
+}
+
+func (c *DingTalkClient) do(ctx context.Context, method, rawURL string, body any, out interface{ err() error }) error {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+		reader = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		raw, _ := io.ReadAll(res.Body)
+		return errors.Errorf("unexpected status %d: %s", res.StatusCode, string(raw))
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return out.err()
+}
+
+func (c *DingTalkClient) getAccessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.accessToken != "" && c.now().Before(c.accessTokenExpire) {
+		return c.accessToken, nil
+	}
+
+	var res struct {
+		apiResponse
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	query := url.Values{"appkey": {c.appKey}, "appsecret": {c.appSecret}}
+	if err := c.do(ctx, http.MethodGet, apiEndpoint+"/gettoken?"+query.Encode(), nil, &res); err != nil {
+		return "", errors.Wrap(err, "failed to get access token")
+	}
+	c.accessToken = res.AccessToken
+	c.accessTokenExpire = c.now().Add(time.Duration(res.ExpiresIn)*time.Second - accessTokenLeeway)
+	return c.accessToken, nil
+}
+
+func (c *DingTalkClient) SendWorkNotification(ctx context.Context, userIDs []string, content string) error {
+	log.Infof("sending work notification to %s", strings.Join(userIDs, ","))
+	token, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	req := map[string]any{
+		"agent_id":    c.agentID,
+		"userid_list": strings.Join(userIDs, ","),
+		"msg":         newTextMessage(content),
+	}
+	var res struct {
+		apiResponse
+		TaskID int64 `json:"task_id"`
+	}
+	query := url.Values{"access_token": {token}}
+	if err := c.do(ctx, http.MethodPost, apiEndpoint+"/topapi/message/corpconversation/asyncsend_v2?"+query.Encode(), req, &res); err != nil {
+		return errors.Wrap(err, "failed to send work notification")
+	}
+	return nil
+}
+
+// signRobot signs the robot webhook request, which is the base64 HMAC-SHA256 of
+// the timestamp and the secret joined by a newline, keyed by the secret.
+func signRobot(timestamp int64, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d\n%s", timestamp, secret)))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (c *DingTalkClient) SendRobotMessage(ctx context.Context, content string, atMobiles []string) error {
+	log.Infof("sending robot message")
+	msg := newTextMessage(content)
+	if len(atMobiles) > 0 {
+		msg.At = &struct {
+			AtMobiles []string `json:"atMobiles"`
+		}{AtMobiles: atMobiles}
+	}
+	query := url.Values{"access_token": {c.robotToken}}
+	if c.robotSecret != "" {
+		timestamp := c.now().UnixMilli()
+		query.Set("timestamp", fmt.Sprintf("%d", timestamp))
+		query.Set("sign", signRobot(timestamp, c.robotSecret))
+	}
+	var res apiResponse
+	if err := c.do(ctx, http.MethodPost, apiEndpoint+"/robot/send?"+query.Encode(), msg, &res); err != nil {
+		return errors.Wrap(err, "failed to send robot message")
+	}
+	return nil
+}
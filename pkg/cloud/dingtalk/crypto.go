@@ -0,0 +1,114 @@
+package dingtalk
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// callbackCrypto implements the encryption of the DingTalk event subscription, the
+// payload is AES-256-CBC encrypted as random(16) + len(4) + msg + appKey with PKCS#7
+// padding to 32 bytes, and the key is the base64 decoded aes key.
+type callbackCrypto struct {
+	token  string
+	key    []byte
+	appKey string
+}
+
+func newCallbackCrypto(token, aesKey, appKey string) (*callbackCrypto, error) {
+	key, err := base64.StdEncoding.DecodeString(aesKey + "=")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode aes key")
+	}
+	if len(key) != 32 {
+		return nil, errors.Errorf("aes key must be 43 characters, got %d", len(aesKey))
+	}
+	return &callbackCrypto{
+		token:  token,
+		key:    key,
+		appKey: appKey,
+	}, nil
+}
+
+// Sign computes the signature of the callback, which is the hex SHA-1 of the sorted
+// token, timestamp, nonce and encrypted payload.
+func Sign(token, timestamp, nonce, encrypt string) string {
+	parts := []string{token, timestamp, nonce, encrypt}
+	sort.Strings(parts)
+	sum := sha1.Sum([]byte(strings.Join(parts, "")))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTimestampAndNonce() (string, string) {
+	nonce := make([]byte, 8)
+	_, _ = rand.Read(nonce)
+	return fmt.Sprintf("%d", time.Now().UnixMilli()), hex.EncodeToString(nonce)
+}
+
+func (c *callbackCrypto) encrypt(msg []byte) (string, error) {
+	var buf bytes.Buffer
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", errors.Wrap(err, "failed to generate random bytes")
+	}
+	buf.Write(random)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(msg)))
+	buf.Write(msg)
+	buf.WriteString(c.appKey)
+
+	pad := 32 - buf.Len()%32
+	buf.Write(bytes.Repeat([]byte{byte(pad)}, pad))
+
+	block, err := aes.NewCipher(c.key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create cipher")
+	}
+	ciphertext := make([]byte, buf.Len())
+	cipher.NewCBCEncrypter(block, c.key[:aes.BlockSize]).CryptBlocks(ciphertext, buf.Bytes())
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func (c *callbackCrypto) decrypt(encrypt string) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode payload")
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid payload length")
+	}
+	block, err := aes.NewCipher(c.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	plain := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, c.key[:aes.BlockSize]).CryptBlocks(plain, ciphertext)
+
+	pad := int(plain[len(plain)-1])
+	if pad < 1 || pad > 32 || pad > len(plain) {
+		return nil, errors.New("invalid padding")
+	}
+	plain = plain[:len(plain)-pad]
+	if len(plain) < 20 {
+		return nil, errors.New("payload too short")
+	}
+	size := int(binary.BigEndian.Uint32(plain[16:20]))
+	if size > len(plain)-20 {
+		return nil, errors.New("invalid message length")
+	}
+	msg, receiver := plain[20:20+size], string(plain[20+size:])
+	if receiver != c.appKey {
+		return nil, errors.Errorf("payload is for %s", receiver)
+	}
+	return msg, nil
+}
@@ -0,0 +1,268 @@
+package dingtalk
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/logger"
+)
+
+const (
+	FakeToken  = "9527"
+	FakeAppKey = "fake"
+)
+
+const (
+	apiEndpoint = "https://oapi.dingtalk.com"
+	httpTimeout = 10 * time.Second
+	// events older than this are rejected to prevent replay
+	callbackMaxAge = 5 * time.Minute
+)
+
+var (
+	log = logger.NewLogAgent("dingtalk")
+
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrDingTalkDisabled = errors.New("dingtalk is disabled")
+)
+
+// Event is the decrypted event pushed to the callback url, the payload is decoded into
+// the typed event of its type with Decode.
+type Event struct {
+	Type   string
+	CorpID string
+	// the decrypted payload
+	Raw json.RawMessage
+}
+
+// Decode decodes the payload into the typed event, e.g. UserChangeEvent.
+func (e *Event) Decode(v any) error {
+	if err := json.Unmarshal(e.Raw, v); err != nil {
+		return errors.Wrapf(err, "failed to decode %s event", e.Type)
+	}
+	return nil
+}
+
+// UserChangeEvent is the payload of the user_add_org, user_modify_org and
+// user_leave_org events.
+type UserChangeEvent struct {
+	CorpID string `json:"CorpId"`
+	// ids of the users in the corp affected by the event
+	UserIDs   []string `json:"UserId"`
+	TimeStamp string   `json:"TimeStamp"`
+}
+
+// BpmsInstanceChangeEvent is the payload of the bpms_instance_change event, it is
+// pushed when an approval instance is started or finished.
+type BpmsInstanceChangeEvent struct {
+	CorpID            string `json:"corpId"`
+	ProcessInstanceID string `json:"processInstanceId"`
+	ProcessCode       string `json:"processCode"`
+	Title             string `json:"title"`
+	URL               string `json:"url"`
+	// start, finish or terminate
+	Type string `json:"type"`
+	// agree or refuse, set once the instance is finished
+	Result string `json:"result"`
+	// id of the user who started the instance
+	StaffID string `json:"staffId"`
+	// milliseconds since epoch
+	CreateTime int64 `json:"createTime"`
+	FinishTime int64 `json:"finishTime"`
+}
+
+// CallbackRequest is the body pushed to the callback url.
+type CallbackRequest struct {
+	Encrypt string `json:"encrypt"`
+}
+
+// CallbackResponse is the encrypted response DingTalk expects from the callback url.
+type CallbackResponse struct {
+	MsgSignature string `json:"msg_signature"`
+	TimeStamp    string `json:"timeStamp"`
+	Nonce        string `json:"nonce"`
+	Encrypt      string `json:"encrypt"`
+}
+
+type DingTalkClientInterface interface {
+	// SendWorkNotification sends a text work notification to the users through the app agent.
+	SendWorkNotification(ctx context.Context, userIDs []string, content string) error
+
+	// SendRobotMessage sends a text message to the group of the custom robot,
+	// the members with the mobiles are mentioned.
+	SendRobotMessage(ctx context.Context, content string, atMobiles []string) error
+
+	// ParseEvent verifies the signature of the event pushed to the callback url and
+	// decrypts it, ErrInvalidSignature is returned if the verification fails.
+	ParseEvent(signature, timestamp, nonce string, body []byte) (*Event, error)
+
+	// EncryptResponse builds the response to the callback.
+	EncryptResponse(msg string) (*CallbackResponse, error)
+}
+
+func NewDingTalkClient(cfg *config.Config) (DingTalkClientInterface, error) {
+	if !cfg.DingTalk.Enable {
+		if cfg.FakeDingTalk {
+			log.Warn("fake dingtalk is enabled, dingtalk events can be forged by anyone")
+			return &FakeDingTalkClient{}, nil
+		}
+		return &NoneDingTalkClient{}, nil
+	}
+	crypto, err := newCallbackCrypto(cfg.DingTalk.Token, cfg.DingTalk.AesKey, cfg.DingTalk.AppKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init dingtalk callback crypto")
+	}
+	return &DingTalkClient{
+		appKey:      cfg.DingTalk.AppKey,
+		appSecret:   cfg.DingTalk.AppSecret,
+		agentID:     cfg.DingTalk.AgentId,
+		robotToken:  cfg.DingTalk.RobotToken,
+		robotSecret: cfg.DingTalk.RobotSecret,
+		crypto:      crypto,
+		httpClient:  &http.Client{Timeout: httpTimeout},
+		now:         time.Now,
+	}, nil
+}
+
+// NoneDingTalkClient is used when DingTalk is not enabled, it drops the messages and
+// rejects all events.
+type NoneDingTalkClient struct {
+}
+
+func (n *NoneDingTalkClient) SendWorkNotification(ctx context.Context, userIDs []string, content string) error {
+	log.Infof("dingtalk is disabled, dropping work notification to %s", strings.Join(userIDs, ","))
+	return nil
+}
+
+func (n *NoneDingTalkClient) SendRobotMessage(ctx context.Context, content string, atMobiles []string) error {
+	log.Info("dingtalk is disabled, dropping robot message")
+	return nil
+}
+
+func (n *NoneDingTalkClient) ParseEvent(signature, timestamp, nonce string, body []byte) (*Event, error) {
+	return nil, ErrInvalidSignature
+}
+
+func (n *NoneDingTalkClient) EncryptResponse(msg string) (*CallbackResponse, error) {
+	return nil, ErrDingTalkDisabled
+}
+
+// FakeDingTalkClient logs the messages instead of sending them, and accepts events
+// whose encrypt field is the plain JSON payload signed with FakeToken.
+type FakeDingTalkClient struct {
+}
+
+func (f *FakeDingTalkClient) SendWorkNotification(ctx context.Context, userIDs []string, content string) error {
+	log.Infof("sending work notification to %s: %s", strings.Join(userIDs, ","), content)
+	return nil
+}
+
+func (f *FakeDingTalkClient) SendRobotMessage(ctx context.Context, content string, atMobiles []string) error {
+	log.Infof("sending robot message: %s", content)
+	return nil
+}
+
+func (f *FakeDingTalkClient) ParseEvent(signature, timestamp, nonce string, body []byte) (*Event, error) {
+	var req CallbackRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal callback request")
+	}
+	if err := verify(FakeToken, signature, timestamp, nonce, req.Encrypt, time.Now()); err != nil {
+		return nil, err
+	}
+	return parseEvent([]byte(req.Encrypt))
+}
+
+func (f *FakeDingTalkClient) EncryptResponse(msg string) (*CallbackResponse, error) {
+	timestamp, nonce := newTimestampAndNonce()
+	return &CallbackResponse{
+		MsgSignature: Sign(FakeToken, timestamp, nonce, msg),
+		TimeStamp:    timestamp,
+		Nonce:        nonce,
+		Encrypt:      msg,
+	}, nil
+}
+
+type DingTalkClient struct {
+	appKey      string
+	appSecret   string
+	agentID     int64
+	robotToken  string
+	robotSecret string
+	crypto      *callbackCrypto
+	httpClient  *http.Client
+	now         func() time.Time
+
+	mu                sync.Mutex
+	accessToken       string
+	accessTokenExpire time.Time
+}
+
+func (c *DingTalkClient) ParseEvent(signature, timestamp, nonce string, body []byte) (*Event, error) {
+	var req CallbackRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal callback request")
+	}
+	if err := verify(c.crypto.token, signature, timestamp, nonce, req.Encrypt, c.now()); err != nil {
+		return nil, err
+	}
+	plain, err := c.crypto.decrypt(req.Encrypt)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return parseEvent(plain)
+}
+
+func (c *DingTalkClient) EncryptResponse(msg string) (*CallbackResponse, error) {
+	encrypt, err := c.crypto.encrypt([]byte(msg))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt callback response")
+	}
+	timestamp, nonce := newTimestampAndNonce()
+	return &CallbackResponse{
+		MsgSignature: Sign(c.crypto.token, timestamp, nonce, encrypt),
+		TimeStamp:    timestamp,
+		Nonce:        nonce,
+		Encrypt:      encrypt,
+	}, nil
+}
+
+// verify checks the signature of the callback in constant time, and that the timestamp
+// in milliseconds is within callbackMaxAge of now.
+func verify(token, signature, timestamp, nonce, encrypt string, now time.Time) error {
+	if !hmac.Equal([]byte(signature), []byte(Sign(token, timestamp, nonce, encrypt))) {
+		return ErrInvalidSignature
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || now.Sub(time.UnixMilli(ts)).Abs() > callbackMaxAge {
+		return errors.Wrap(ErrInvalidSignature, "stale timestamp")
+	}
+	return nil
+}
+
+type eventPayload struct {
+	EventType string `json:"EventType"`
+	// CorpId of the contact events, the key is matched case-insensitively so corpId of
+	// the approval events is read as well
+	CorpID string `json:"CorpId"`
+}
+
+func parseEvent(plain []byte) (*Event, error) {
+	var p eventPayload
+	if err := json.Unmarshal(plain, &p); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal event")
+	}
+	return &Event{
+		Type:   p.EventType,
+		CorpID: p.CorpID,
+		Raw:    plain,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package dingtalk
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+func TestParseEvent(t *testing.T) {
+	var (
+		now    = time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+		nonce  = "abcdefgh"
+		aesKey = strings.Repeat("a", 43)
+	)
+
+	crypto, err := newCallbackCrypto("token", aesKey, "appkey")
+	require.NoError(t, err)
+	client := &DingTalkClient{
+		crypto: crypto,
+		now:    func() time.Time { return now },
+	}
+
+	encrypt, err := crypto.encrypt([]byte(`{"EventType":"user_leave_org","CorpId":"corp","UserId":["u1"]}`))
+	require.NoError(t, err)
+	body, err := json.Marshal(CallbackRequest{Encrypt: encrypt})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		timestamp time.Time
+		signature func(timestamp string) string
+		valid     bool
+	}{
+		{
+			timestamp: now.Add(-time.Minute),
+			signature: func(timestamp string) string { return Sign("token", timestamp, nonce, encrypt) },
+			valid:     true,
+		},
+		{
+			// replayed
+			timestamp: now.Add(-callbackMaxAge - time.Second),
+			signature: func(timestamp string) string { return Sign("token", timestamp, nonce, encrypt) },
+		},
+		{
+			timestamp: now,
+			signature: func(timestamp string) string { return Sign("other", timestamp, nonce, encrypt) },
+		},
+	}
+
+	for _, testCase := range testCases {
+		timestamp := strconv.FormatInt(testCase.timestamp.UnixMilli(), 10)
+		event, err := client.ParseEvent(testCase.signature(timestamp), timestamp, nonce, body)
+		if !testCase.valid {
+			assert.ErrorIs(t, err, ErrInvalidSignature)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "user_leave_org", event.Type)
+		assert.Equal(t, "corp", event.CorpID)
+
+		var e UserChangeEvent
+		require.NoError(t, event.Decode(&e))
+		assert.Equal(t, []string{"u1"}, e.UserIDs)
+	}
+
+	// the response is decrypted with the same key
+	res, err := client.EncryptResponse("success")
+	require.NoError(t, err)
+	assert.Equal(t, Sign("token", res.TimeStamp, res.Nonce, res.Encrypt), res.MsgSignature)
+	plain, err := crypto.decrypt(res.Encrypt)
+	require.NoError(t, err)
+	assert.Equal(t, "success", string(plain))
+}
+
+func TestNewDingTalkClient(t *testing.T) {
+	encrypt := `{"EventType":"user_leave_org","CorpId":"corp","UserId":["u1"]}`
+	body, err := json.Marshal(CallbackRequest{Encrypt: encrypt})
+	require.NoError(t, err)
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	signature := Sign(FakeToken, timestamp, "nonce", encrypt)
+
+	// the events signed with the public fake token are only accepted with the dev flag
+	client, err := NewDingTalkClient(&config.Config{})
+	require.NoError(t, err)
+	_, err = client.ParseEvent(signature, timestamp, "nonce", body)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = client.EncryptResponse("success")
+	assert.ErrorIs(t, err, ErrDingTalkDisabled)
+
+	client, err = NewDingTalkClient(&config.Config{FakeDingTalk: true})
+	require.NoError(t, err)
+	event, err := client.ParseEvent(signature, timestamp, "nonce", body)
+	require.NoError(t, err)
+	assert.Equal(t, "user_leave_org", event.Type)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloud/dingtalk/dingtalk.go
+
+// Package dingtalk is a generated GoMock package.
+package dingtalk
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDingTalkClientInterface is a mock of DingTalkClientInterface interface.
+type MockDingTalkClientInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDingTalkClientInterfaceMockRecorder
+}
+
+// MockDingTalkClientInterfaceMockRecorder is the mock recorder for MockDingTalkClientInterface.
+type MockDingTalkClientInterfaceMockRecorder struct {
+	mock *MockDingTalkClientInterface
+}
+
+// NewMockDingTalkClientInterface creates a new mock instance.
+func NewMockDingTalkClientInterface(ctrl *gomock.Controller) *MockDingTalkClientInterface {
+	mock := &MockDingTalkClientInterface{ctrl: ctrl}
+	mock.recorder = &MockDingTalkClientInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDingTalkClientInterface) EXPECT() *MockDingTalkClientInterfaceMockRecorder {
+	return m.recorder
+}
+
+// EncryptResponse mocks base method.
+func (m *MockDingTalkClientInterface) EncryptResponse(msg string) (*CallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptResponse", msg)
+	ret0, _ := ret[0].(*CallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptResponse indicates an expected call of EncryptResponse.
+func (mr *MockDingTalkClientInterfaceMockRecorder) EncryptResponse(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptResponse", reflect.TypeOf((*MockDingTalkClientInterface)(nil).EncryptResponse), msg)
+}
+
+// ParseEvent mocks base method.
+func (m *MockDingTalkClientInterface) ParseEvent(signature, timestamp, nonce string, body []byte) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseEvent", signature, timestamp, nonce, body)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseEvent indicates an expected call of ParseEvent.
+func (mr *MockDingTalkClientInterfaceMockRecorder) ParseEvent(signature, timestamp, nonce, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseEvent", reflect.TypeOf((*MockDingTalkClientInterface)(nil).ParseEvent), signature, timestamp, nonce, body)
+}
+
+// SendRobotMessage mocks base method.
+func (m *MockDingTalkClientInterface) SendRobotMessage(ctx context.Context, content string, atMobiles []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRobotMessage", ctx, content, atMobiles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRobotMessage indicates an expected call of SendRobotMessage.
+func (mr *MockDingTalkClientInterfaceMockRecorder) SendRobotMessage(ctx, content, atMobiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRobotMessage", reflect.TypeOf((*MockDingTalkClientInterface)(nil).SendRobotMessage), ctx, content, atMobiles)
+}
+
+// SendWorkNotification mocks base method.
+func (m *MockDingTalkClientInterface) SendWorkNotification(ctx context.Context, userIDs []string, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWorkNotification", ctx, userIDs, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendWorkNotification indicates an expected call of SendWorkNotification.
+func (mr *MockDingTalkClientInterfaceMockRecorder) SendWorkNotification(ctx, userIDs, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWorkNotification", reflect.TypeOf((*MockDingTalkClientInterface)(nil).SendWorkNotification), ctx, userIDs, content)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloud/payment/payment.go
+
+// Package payment is a generated GoMock package.
+package payment
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentProviderInterface is a mock of PaymentProviderInterface interface.
+type MockPaymentProviderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentProviderInterfaceMockRecorder
+}
+
+// MockPaymentProviderInterfaceMockRecorder is the mock recorder for MockPaymentProviderInterface.
+type MockPaymentProviderInterfaceMockRecorder struct {
+	mock *MockPaymentProviderInterface
+}
+
+// NewMockPaymentProviderInterface creates a new mock instance.
+func NewMockPaymentProviderInterface(ctrl *gomock.Controller) *MockPaymentProviderInterface {
+	mock := &MockPaymentProviderInterface{ctrl: ctrl}
+	mock.recorder = &MockPaymentProviderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentProviderInterface) EXPECT() *MockPaymentProviderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateOrder mocks base method.
+func (m *MockPaymentProviderInterface) CreateOrder(ctx context.Context, order Order) (*Prepay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, order)
+	ret0, _ := ret[0].(*Prepay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockPaymentProviderInterfaceMockRecorder) CreateOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockPaymentProviderInterface)(nil).CreateOrder), ctx, order)
+}
+
+// Name mocks base method.
+func (m *MockPaymentProviderInterface) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentProviderInterfaceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentProviderInterface)(nil).Name))
+}
+
+// ParseNotification mocks base method.
+func (m *MockPaymentProviderInterface) ParseNotification(header http.Header, body []byte) (*Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseNotification", header, body)
+	ret0, _ := ret[0].(*Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseNotification indicates an expected call of ParseNotification.
+func (mr *MockPaymentProviderInterfaceMockRecorder) ParseNotification(header, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseNotification", reflect.TypeOf((*MockPaymentProviderInterface)(nil).ParseNotification), header, body)
+}
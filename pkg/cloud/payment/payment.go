@@ -0,0 +1,143 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/logger"
+)
+
+const (
+	ProviderNone   = "none"
+	ProviderFake   = "fake"
+	ProviderWechat = "wechat"
+)
+
+const (
+	FakeSecret          = "9527"
+	FakeSignatureHeader = "X-Fake-Signature"
+)
+
+var (
+	log = logger.NewLogAgent("payment")
+
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrPaymentDisabled  = errors.New("payment is disabled")
+)
+
+type Order struct {
+	// ID of the recharge order in our system
+	ID string
+	// amount in cents
+	Amount      int64
+	Description string
+	ExpiredAt   time.Time
+}
+
+type Prepay struct {
+	// CodeURL is rendered as a QR code for the payer to scan
+	CodeURL string
+}
+
+type Notification struct {
+	// ID of the recharge order in our system
+	OrderID string
+	// ID of the trade in the payment provider
+	TradeID string
+	Paid    bool
+	// amount in cents
+	Amount int64
+}
+
+type PaymentProviderInterface interface {
+	Name() string
+
+	CreateOrder(ctx context.Context, order Order) (*Prepay, error)
+
+	// ParseNotification verifies the signature of the notification sent by the provider
+	// and parses it, ErrInvalidSignature is returned if the verification fails.
+	ParseNotification(header http.Header, body []byte) (*Notification, error)
+}
+
+func NewPaymentProvider(cfg *config.Config) (PaymentProviderInterface, error) {
+	if cfg.WxPay.Enable {
+		return NewWechatPay(cfg)
+	}
+	if cfg.FakePayment {
+		log.Warn("fake payment is enabled, payment notifications can be forged by anyone")
+		return &FakePaymentProvider{}, nil
+	}
+	return &NonePaymentProvider{}, nil
+}
+
+// NonePaymentProvider is used when no payment provider is enabled, it refuses all
+// orders and rejects all notifications.
+type NonePaymentProvider struct {
+}
+
+func (n *NonePaymentProvider) Name() string {
+	return ProviderNone
+}
+
+func (n *NonePaymentProvider) CreateOrder(ctx context.Context, order Order) (*Prepay, error) {
+	return nil, ErrPaymentDisabled
+}
+
+func (n *NonePaymentProvider) ParseNotification(header http.Header, body []byte) (*Notification, error) {
+	return nil, ErrInvalidSignature
+}
+
+// FakeNotification is the body of the notification accepted by FakePaymentProvider.
+type FakeNotification struct {
+	OrderID string `json:"orderId"`
+	TradeID string `json:"tradeId"`
+	Paid    bool   `json:"paid"`
+	Amount  int64  `json:"amount"`
+}
+
+// FakeSign signs the notification body for FakePaymentProvider.
+func FakeSign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(FakeSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// FakePaymentProvider accepts notifications signed by FakeSign, it is used for
+// development and testing.
+type FakePaymentProvider struct {
+}
+
+func (f *FakePaymentProvider) Name() string {
+	return ProviderFake
+}
+
+func (f *FakePaymentProvider) CreateOrder(ctx context.Context, order Order) (*Prepay, error) {
+	log.Infof("creating order %s with amount %d", order.ID, order.Amount)
+	return &Prepay{
+		CodeURL: fmt.Sprintf("fake://pay?order=%s&amount=%d", order.ID, order.Amount),
+	}, nil
+}
+
+func (f *FakePaymentProvider) ParseNotification(header http.Header, body []byte) (*Notification, error) {
+	if !hmac.Equal([]byte(header.Get(FakeSignatureHeader)), []byte(FakeSign(body))) {
+		return nil, ErrInvalidSignature
+	}
+	var n FakeNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal notification")
+	}
+	return &Notification{
+		OrderID: n.OrderID,
+		TradeID: n.TradeID,
+		Paid:    n.Paid,
+		Amount:  n.Amount,
+	}, nil
+}
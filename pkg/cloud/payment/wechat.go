@@ -0,0 +1,283 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+const (
+	wechatPayHost = "https://api.mch.weixin.qq.com"
+	// notifications older than this are rejected to prevent replay
+	wechatNotifyMaxAge = 5 * time.Minute
+)
+
+// WechatPay is an adapter of WeChat Pay API v3 native payment.
+// https://pay.weixin.qq.com/wiki/doc/apiv3/apis/chapter3_4_1.shtml
+type WechatPay struct {
+	appId     string
+	mchId     string
+	serialNo  string
+	notifyUrl string
+	apiV3Key  []byte
+
+	privateKey     *rsa.PrivateKey
+	platformPubKey *rsa.PublicKey
+
+	client *http.Client
+}
+
+func NewWechatPay(cfg *config.Config) (*WechatPay, error) {
+	block, _ := pem.Decode([]byte(cfg.WxPay.PrivateKey))
+	if block == nil {
+		return nil, errors.New("failed to decode wechat pay private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse wechat pay private key")
+	}
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("wechat pay private key is not a RSA key")
+	}
+
+	block, _ = pem.Decode([]byte(cfg.WxPay.PlatformCert))
+	if block == nil {
+		return nil, errors.New("failed to decode wechat pay platform certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse wechat pay platform certificate")
+	}
+	platformPubKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("wechat pay platform certificate is not a RSA certificate")
+	}
+
+	if len(cfg.WxPay.ApiV3Key) != 32 {
+		return nil, errors.New("wechat pay api v3 key must be 32 bytes")
+	}
+
+	return &WechatPay{
+		appId:          cfg.WxPay.AppId,
+		mchId:          cfg.WxPay.MchId,
+		serialNo:       cfg.WxPay.SerialNo,
+		notifyUrl:      cfg.WxPay.NotifyUrl,
+		apiV3Key:       []byte(cfg.WxPay.ApiV3Key),
+		privateKey:     privateKey,
+		platformPubKey: platformPubKey,
+		client:         &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (w *WechatPay) Name() string {
+	return ProviderWechat
+}
+
+// outTradeNo turns the order ID into the format accepted by WeChat Pay, which allows 6-32 characters.
+func outTradeNo(orderID string) string {
+	id, err := uuid.Parse(orderID)
+	if err != nil {
+		return orderID
+	}
+	return hex.EncodeToString(id[:])
+}
+
+func orderIDFromOutTradeNo(no string) string {
+	id, err := uuid.Parse(no)
+	if err != nil {
+		return no
+	}
+	return id.String()
+}
+
+func nonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// authorization signs the request with the merchant private key.
+func (w *WechatPay) authorization(method string, path string, body []byte) (string, error) {
+	nonceStr, err := nonce()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate nonce")
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	message := fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n", method, path, timestamp, nonceStr, body)
+	hashed := sha256.Sum256([]byte(message))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, w.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign request")
+	}
+	return fmt.Sprintf(
+		`WECHATPAY2-SHA256-RSA2048 mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		w.mchId, nonceStr, base64.StdEncoding.EncodeToString(signature), timestamp, w.serialNo,
+	), nil
+}
+
+type wechatAmount struct {
+	Total    int64  `json:"total"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type wechatNativeOrder struct {
+	AppId       string       `json:"appid"`
+	MchId       string       `json:"mchid"`
+	Description string       `json:"description"`
+	OutTradeNo  string       `json:"out_trade_no"`
+	TimeExpire  string       `json:"time_expire"`
+	NotifyUrl   string       `json:"notify_url"`
+	Amount      wechatAmount `json:"amount"`
+}
+
+func (w *WechatPay) CreateOrder(ctx context.Context, order Order) (*Prepay, error) {
+	path := "/v3/pay/transactions/native"
+	body, err := json.Marshal(wechatNativeOrder{
+		AppId:       w.appId,
+		MchId:       w.mchId,
+		Description: order.Description,
+		OutTradeNo:  outTradeNo(order.ID),
+		TimeExpire:  order.ExpiredAt.Format(time.RFC3339),
+		NotifyUrl:   w.notifyUrl,
+		Amount: wechatAmount{
+			Total:    order.Amount,
+			Currency: "CNY",
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal order")
+	}
+	auth, err := w.authorization(http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wechatPayHost+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request wechat pay")
+	}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("wechat pay responded with status %d: %s", res.StatusCode, string(resBody))
+	}
+	var prepay struct {
+		CodeURL string `json:"code_url"`
+	}
+	if err := json.Unmarshal(resBody, &prepay); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response")
+	}
+	return &Prepay{CodeURL: prepay.CodeURL}, nil
+}
+
+type wechatNotification struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type"`
+	Resource  struct {
+		Algorithm      string `json:"algorithm"`
+		Ciphertext     string `json:"ciphertext"`
+		AssociatedData string `json:"associated_data"`
+		Nonce          string `json:"nonce"`
+	} `json:"resource"`
+}
+
+type wechatTransaction struct {
+	OutTradeNo    string       `json:"out_trade_no"`
+	TransactionId string       `json:"transaction_id"`
+	TradeState    string       `json:"trade_state"`
+	Amount        wechatAmount `json:"amount"`
+}
+
+// verify checks the signature of the notification with the platform certificate.
+func (w *WechatPay) verify(header http.Header, body []byte) error {
+	timestamp := header.Get("Wechatpay-Timestamp")
+	nonceStr := header.Get("Wechatpay-Nonce")
+	signature, err := base64.StdEncoding.DecodeString(header.Get("Wechatpay-Signature"))
+	if err != nil || len(timestamp) == 0 || len(nonceStr) == 0 {
+		return ErrInvalidSignature
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(ts, 0)).Abs() > wechatNotifyMaxAge {
+		return ErrInvalidSignature
+	}
+	message := fmt.Sprintf("%s\n%s\n%s\n", timestamp, nonceStr, body)
+	hashed := sha256.Sum256([]byte(message))
+	if err := rsa.VerifyPKCS1v15(w.platformPubKey, crypto.SHA256, hashed[:], signature); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (w *WechatPay) ParseNotification(header http.Header, body []byte) (*Notification, error) {
+	if err := w.verify(header, body); err != nil {
+		return nil, err
+	}
+	var n wechatNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal notification")
+	}
+	if n.Resource.Algorithm != "AEAD_AES_256_GCM" {
+		return nil, errors.Errorf("unsupported algorithm %s", n.Resource.Algorithm)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(n.Resource.Ciphertext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode ciphertext")
+	}
+	block, err := aes.NewCipher(w.apiV3Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	aead, err := cipher.NewGCMWithNonceSize(block, len(n.Resource.Nonce))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create gcm")
+	}
+	plaintext, err := aead.Open(nil, []byte(n.Resource.Nonce), ciphertext, []byte(n.Resource.AssociatedData))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt notification resource")
+	}
+
+	var txn wechatTransaction
+	if err := json.Unmarshal(plaintext, &txn); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal transaction")
+	}
+	return &Notification{
+		OrderID: orderIDFromOutTradeNo(txn.OutTradeNo),
+		TradeID: txn.TransactionId,
+		Paid:    txn.TradeState == "SUCCESS",
+		Amount:  txn.Amount.Total,
+	}, nil
+}
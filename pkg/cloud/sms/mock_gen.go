@@ -5,6 +5,7 @@
 package sms
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -33,6 +34,20 @@ func (m *MockSMSManagerInterface) EXPECT() *MockSMSManagerInterfaceMockRecorder
 	return m.recorder
 }
 
+// Check mocks base method.
+func (m *MockSMSManagerInterface) Check(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockSMSManagerInterfaceMockRecorder) Check(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockSMSManagerInterface)(nil).Check), ctx)
+}
+
 // GenerateCode mocks base method.
 func (m *MockSMSManagerInterface) GenerateCode() string {
 	m.ctrl.T.Helper()
@@ -1,9 +1,12 @@
 package sms
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -23,6 +26,9 @@ var (
 type SMSManagerInterface interface {
 	SendCode(phone string, vcode string) error
 	GenerateCode() string
+	// Check returns an error if the provider cannot send codes, it is a readiness check
+	// and sends nothing.
+	Check(ctx context.Context) error
 }
 
 type FakeSMSManager struct {
@@ -37,6 +43,10 @@ func (f *FakeSMSManager) GenerateCode() string {
 	return FakeCode
 }
 
+func (f *FakeSMSManager) Check(ctx context.Context) error {
+	return nil
+}
+
 type SMSManager struct {
 	secretKey     string
 	secretId      string
@@ -102,3 +112,24 @@ func (m *SMSManager) SendCode(phone string, vcode string) error {
 func (m *SMSManager) GenerateCode() string {
 	return fmt.Sprintf("%d", (1+rand.Intn(10))*10000+rand.Intn(10000))
 }
+
+// Check makes sure the settings required to send codes are present.
+func (m *SMSManager) Check(ctx context.Context) error {
+	var missing []string
+	for name, v := range map[string]string{
+		"secretid":      m.secretId,
+		"secretkey":     m.secretKey,
+		"id":            m.smsId,
+		"smssigname":    m.smsSigName,
+		"smstemplateid": m.smsTemplateId,
+	} {
+		if len(v) == 0 {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("tcsms is enabled but %s is not set", strings.Join(missing, ", "))
+	}
+	return nil
+}
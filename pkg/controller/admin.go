@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+)
+
+// the admin endpoints are guarded by the admin access rule in the server middleware
+
+func (a *Controller) GetAdminScheduledTaskRuns(c *fiber.Ctx, params apigen.GetAdminScheduledTaskRunsParams) error {
+	page, err := parsePageParams(params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		return err
+	}
+	runs, err := a.svc.ListScheduledTaskRuns(c.Context(), params.Task, page)
+	if err != nil {
+		return err
+	}
+	return c.Status(200).JSON(runs)
+}
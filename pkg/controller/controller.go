@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/xich-dev/go-starter/pkg/apigen"
 	"github.com/xich-dev/go-starter/pkg/middleware"
@@ -75,6 +76,9 @@ func (a *Controller) PostAuthCode(c *fiber.Ctx) error {
 		if errors.Is(err, service.ErrCodeNotExpired) {
 			return c.SendStatus(http.StatusTooManyRequests)
 		}
+		if errors.Is(err, service.ErrQuotaExceeded) {
+			return c.Status(http.StatusTooManyRequests).SendString(err.Error())
+		}
 		return errors.Wrap(err, "failed to create code")
 	}
 	return c.SendStatus(202)
@@ -124,6 +128,33 @@ func (a *Controller) GetAuthPing(c *fiber.Ctx) error {
 	return nil
 }
 
+func (a *Controller) PostAuthDeleteAccount(c *fiber.Ctx) error {
+	user, ok := c.Locals(middleware.UserContextKey).(*middleware.User)
+	if !ok {
+		return c.Status(http.StatusForbidden).SendString("无法从context获取user")
+	}
+	var param apigen.PostAuthDeleteAccountJSONBody
+	if err := c.BodyParser(&param); err != nil {
+		return c.SendStatus(400)
+	}
+	if len(param.Password) == 0 {
+		return c.Status(400).SendString("密码不能为空")
+	}
+	if err := a.svc.DeleteAccount(c.Context(), user.Id, param.Password); err != nil {
+		if errors.Is(err, service.ErrUsernameOrPhoneNotFound) {
+			return c.Status(404).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrIncorrectPassword) {
+			return c.Status(403).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrOrgHasMembers) {
+			return c.Status(409).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to delete account")
+	}
+	return c.SendStatus(200)
+}
+
 func (a *Controller) PostAuthRefreshToken(c *fiber.Ctx) error {
 	return nil
 }
@@ -155,6 +186,12 @@ func (a *Controller) PostAuthRegister(c *fiber.Ctx) error {
 		if errors.Is(err, service.ErrUsernameAlreadyExist) {
 			return c.Status(400).SendString("该用户名已被注册")
 		}
+		if errors.Is(err, service.ErrUsernameReserved) {
+			return c.Status(400).SendString("该用户名不可用")
+		}
+		if errors.Is(err, service.ErrPhoneReserved) {
+			return c.Status(400).SendString("该手机号不可用")
+		}
 		return errors.Wrap(err, "failed to create user with new org")
 	}
 	return c.SendStatus(200)
@@ -175,3 +212,29 @@ func (a *Controller) GetOrgs(c *fiber.Ctx) error {
 	}
 	return c.Status(200).JSON(rtn)
 }
+
+func (a *Controller) GetOrgsIdMembers(c *fiber.Ctx, id uuid.UUID, params apigen.GetOrgsIdMembersParams) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	page, err := parsePageParams(params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		return err
+	}
+	members, err := a.svc.ListOrgMembers(c.Context(), id, page, parseFilter(params.Keyword))
+	if err != nil {
+		return errors.Wrap(err, "failed to list org members")
+	}
+	return c.Status(200).JSON(members)
+}
+
+func (a *Controller) GetOrgsIdUsage(c *fiber.Ctx, id uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	usage, err := a.svc.GetOrgUsage(c.Context(), id)
+	if err != nil {
+		return errors.Wrap(err, "failed to get org usage")
+	}
+	return c.Status(200).JSON(usage)
+}
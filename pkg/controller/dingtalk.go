@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+func (a *Controller) PostDingtalkCallback(c *fiber.Ctx, params apigen.PostDingtalkCallbackParams) error {
+	res, err := a.svc.HandleDingTalkCallback(c.Context(), params.Signature, params.Timestamp, params.Nonce, c.Body())
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDingTalkCallback) {
+			return c.Status(400).SendString(service.ErrInvalidDingTalkCallback.Error())
+		}
+		return errors.Wrap(err, "failed to handle dingtalk callback")
+	}
+	return c.Status(200).JSON(res)
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// setETag sets the ETag of the response to the version of the entity.
+func setETag(c *fiber.Ctx, version int32) {
+	c.Set(fiber.HeaderETag, fmt.Sprintf(`"%d"`, version))
+}
+
+// parseIfMatch returns the version in the If-Match header, nil is returned if the header
+// is absent or "*", which matches any version. If-Match uses the strong comparison, so a
+// weak tag never matches and the precondition fails.
+func parseIfMatch(ifMatch *string) (*int32, error) {
+	if ifMatch == nil {
+		return nil, nil
+	}
+	v := strings.TrimSpace(*ifMatch)
+	if v == "*" {
+		return nil, nil
+	}
+	if strings.HasPrefix(v, "W/") {
+		return nil, fiber.NewError(fiber.StatusPreconditionFailed, "If-Match不支持弱校验ETag")
+	}
+	v = strings.Trim(v, `"`)
+	version, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "If-Match格式错误")
+	}
+	rtn := int32(version)
+	return &rtn, nil
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	versionOf := func(v int32) *int32 { return &v }
+	header := func(v string) *string { return &v }
+
+	testCases := []struct {
+		ifMatch  *string
+		expected *int32
+		status   int
+	}{
+		{ifMatch: nil, expected: nil},
+		{ifMatch: header("*"), expected: nil},
+		{ifMatch: header(`"3"`), expected: versionOf(3)},
+		{ifMatch: header(` "3" `), expected: versionOf(3)},
+		{ifMatch: header(`W/"3"`), status: fiber.StatusPreconditionFailed},
+		{ifMatch: header(`"abc"`), status: fiber.StatusBadRequest},
+		{ifMatch: header(`"1", "2"`), status: fiber.StatusBadRequest},
+	}
+	for _, testCase := range testCases {
+		version, err := parseIfMatch(testCase.ifMatch)
+		if testCase.status != 0 {
+			var fiberErr *fiber.Error
+			require.True(t, errors.As(err, &fiberErr), *testCase.ifMatch)
+			assert.Equal(t, testCase.status, fiberErr.Code, *testCase.ifMatch)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, version)
+	}
+}
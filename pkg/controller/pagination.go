@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/xich-dev/go-starter/pkg/service"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+// sortCreatedAt is the sort field of the lists paginated in the (created_at, id) order.
+const sortCreatedAt = "createdAt"
+
+// parsePageParams validates the limit, cursor and sort parameters shared by the list
+// endpoints.
+func parsePageParams(limit *int32, cursor *string, sort *string) (service.PageParams, error) {
+	page := service.PageParams{Limit: service.DefaultPageLimit}
+	if limit != nil {
+		if *limit < 1 || *limit > service.MaxPageLimit {
+			return page, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit须在1到%d之间", service.MaxPageLimit))
+		}
+		page.Limit = *limit
+	}
+	if cursor != nil && len(*cursor) > 0 {
+		c, err := utils.DecodeCursor(*cursor)
+		if err != nil {
+			return page, fiber.NewError(fiber.StatusBadRequest, "cursor格式错误")
+		}
+		page.Cursor = c
+	}
+	_, desc, err := parseSort(sort, sortCreatedAt)
+	if err != nil {
+		return page, err
+	}
+	page.Descending = desc
+	return page, nil
+}
+
+// parseSort parses the sort parameter in the form of field or -field for descending,
+// the first field is the default.
+func parseSort(sort *string, fields ...string) (string, bool, error) {
+	if sort == nil || len(*sort) == 0 {
+		return fields[0], false, nil
+	}
+	field, desc := strings.CutPrefix(*sort, "-")
+	if !slices.Contains(fields, field) {
+		return "", false, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("不支持的排序字段%s，可选%s", field, strings.Join(fields, ",")))
+	}
+	return field, desc, nil
+}
+
+// parseFilter trims the filter parameter, nil is returned if it is absent or blank.
+func parseFilter(filter *string) *string {
+	if filter == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*filter)
+	if len(v) == 0 {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+func (a *Controller) GetPlans(c *fiber.Ctx) error {
+	plans, err := a.svc.ListPlans(c.Context())
+	if err != nil {
+		return err
+	}
+	return c.Status(200).JSON(plans)
+}
+
+func (a *Controller) GetOrgsIdSubscription(c *fiber.Ctx, id uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	sub, err := a.svc.GetOrgSubscription(c.Context(), id)
+	if err != nil {
+		return errors.Wrap(err, "failed to get org subscription")
+	}
+	setETag(c, sub.Version)
+	return c.Status(200).JSON(sub)
+}
+
+func (a *Controller) PostOrgsIdSubscription(c *fiber.Ctx, id uuid.UUID, params apigen.PostOrgsIdSubscriptionParams) error {
+	var req apigen.PostOrgsIdSubscriptionJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	version, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return err
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	sub, err := a.svc.Subscribe(c.Context(), id, req.Plan, version)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(409).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrPlanNotFound) {
+			return c.Status(404).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrAlreadySubscribed) {
+			return c.Status(400).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrInsufficientBalance) {
+			return c.Status(402).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to subscribe")
+	}
+	setETag(c, sub.Version)
+	return c.Status(200).JSON(sub)
+}
+
+func (a *Controller) DeleteOrgsIdSubscription(c *fiber.Ctx, id uuid.UUID, params apigen.DeleteOrgsIdSubscriptionParams) error {
+	version, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return err
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.CancelSubscription(c.Context(), id, version); err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(409).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to cancel subscription")
+	}
+	return c.SendStatus(200)
+}
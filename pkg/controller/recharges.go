@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+func (a *Controller) PostOrgsIdRecharges(c *fiber.Ctx, id uuid.UUID) error {
+	var req apigen.PostOrgsIdRechargesJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if req.Amount <= 0 {
+		return c.Status(400).SendString("充值金额必须大于0")
+	}
+	user, err := a.checkOrgAccess(c, id, false)
+	if err != nil {
+		return err
+	}
+	order, err := a.svc.CreateRechargeOrder(c.Context(), id, user.Id, req.Amount)
+	if err != nil {
+		if errors.Is(err, service.ErrPaymentProviderNotFound) {
+			return c.Status(400).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to create recharge order")
+	}
+	return c.Status(200).JSON(order)
+}
+
+func (a *Controller) GetOrgsIdRechargesOrderId(c *fiber.Ctx, id uuid.UUID, orderId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	order, err := a.svc.GetRechargeOrder(c.Context(), id, orderId)
+	if err != nil {
+		if errors.Is(err, service.ErrRechargeOrderNotFound) {
+			return c.Status(404).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to get recharge order")
+	}
+	return c.Status(200).JSON(order)
+}
+
+func (a *Controller) PostPaymentsProviderNotify(c *fiber.Ctx, provider string) error {
+	header := http.Header{}
+	c.Request().Header.VisitAll(func(key, value []byte) {
+		header.Add(string(key), string(value))
+	})
+	if err := a.svc.HandlePaymentNotification(c.Context(), provider, header, c.Body()); err != nil {
+		if errors.Is(err, service.ErrPaymentProviderNotFound) || errors.Is(err, service.ErrRechargeOrderNotFound) {
+			return c.Status(404).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidPaymentNotification) {
+			return c.Status(400).SendString(service.ErrInvalidPaymentNotification.Error())
+		}
+		return errors.Wrap(err, "failed to handle payment notification")
+	}
+	return c.SendStatus(200)
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+func (a *Controller) GetOrgsIdStatements(c *fiber.Ctx, id uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	statements, err := a.svc.ListStatements(c.Context(), id)
+	if err != nil {
+		return errors.Wrap(err, "failed to list statements")
+	}
+	return c.Status(200).JSON(statements)
+}
+
+func (a *Controller) GetOrgsIdStatementsMonth(c *fiber.Ctx, id uuid.UUID, month string, params apigen.GetOrgsIdStatementsMonthParams) error {
+	format := "json"
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != "json" && format != "csv" && format != "pdf" {
+		return c.Status(400).SendString("不支持的格式" + format)
+	}
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	detail, err := a.svc.GetStatement(c.Context(), id, month)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidStatementMonth) || errors.Is(err, service.ErrStatementPeriodNotEnded) || errors.Is(err, service.ErrStatementBeforeOrg) {
+			return c.Status(400).SendString(err.Error())
+		}
+		return errors.Wrap(err, "failed to get statement")
+	}
+
+	filename := fmt.Sprintf("%s.%s", detail.Statement.InvoiceNo, format)
+	switch format {
+	case "csv":
+		body, err := service.RenderStatementCSV(detail)
+		if err != nil {
+			return err
+		}
+		c.Attachment(filename)
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		return c.Status(200).Send(body)
+	case "pdf":
+		c.Attachment(filename)
+		c.Set(fiber.HeaderContentType, "application/pdf")
+		return c.Status(200).Send(service.RenderStatementPDF(detail))
+	default:
+		return c.Status(200).JSON(detail)
+	}
+}
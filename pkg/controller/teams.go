@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/middleware"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+// checkOrgAccess makes sure the current user belongs to the org, if ownerOnly is set,
+// the user must also be the owner of the org.
+func (a *Controller) checkOrgAccess(c *fiber.Ctx, orgID uuid.UUID, ownerOnly bool) (*middleware.User, error) {
+	user, err := middleware.GetUser(c)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusForbidden, err.Error())
+	}
+	if user.OrgID != orgID {
+		return nil, fiber.NewError(http.StatusForbidden, "无权访问该组织")
+	}
+	if ownerOnly {
+		if err := a.svc.CheckOrgOwner(c.Context(), orgID, user.Id); err != nil {
+			if errors.Is(err, service.ErrNotOrgOwner) {
+				return nil, fiber.NewError(http.StatusForbidden, err.Error())
+			}
+			if errors.Is(err, service.ErrOrgNotFound) {
+				return nil, fiber.NewError(http.StatusNotFound, err.Error())
+			}
+			return nil, errors.Wrap(err, "failed to check org owner")
+		}
+	}
+	return user, nil
+}
+
+func teamErrorHandler(c *fiber.Ctx, err error) error {
+	if errors.Is(err, service.ErrTeamNotFound) || errors.Is(err, service.ErrAccessRuleNotFound) {
+		return c.Status(404).SendString(err.Error())
+	}
+	if errors.Is(err, service.ErrTeamAlreadyExist) || errors.Is(err, service.ErrUserNotInOrg) {
+		return c.Status(400).SendString(err.Error())
+	}
+	if errors.Is(err, service.ErrVersionConflict) {
+		return c.Status(409).SendString(err.Error())
+	}
+	if errors.Is(err, service.ErrReservedAccessRule) {
+		return c.Status(403).SendString(err.Error())
+	}
+	return err
+}
+
+func (a *Controller) GetOrgsIdTeams(c *fiber.Ctx, id uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	teams, err := a.svc.ListTeams(c.Context(), id)
+	if err != nil {
+		return err
+	}
+	return c.Status(200).JSON(teams)
+}
+
+func (a *Controller) PostOrgsIdTeams(c *fiber.Ctx, id uuid.UUID) error {
+	var req apigen.PostOrgsIdTeamsJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if len(req.Name) == 0 {
+		return c.Status(400).SendString("团队名称不能为空")
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	team, err := a.svc.CreateTeam(c.Context(), id, req.Name)
+	if err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(team)
+}
+
+func (a *Controller) GetOrgsIdTeamsTeamId(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	detail, err := a.svc.GetTeamDetail(c.Context(), id, teamId)
+	if err != nil {
+		return teamErrorHandler(c, err)
+	}
+	setETag(c, detail.Team.Version)
+	return c.Status(200).JSON(detail)
+}
+
+func (a *Controller) PutOrgsIdTeamsTeamId(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID, params apigen.PutOrgsIdTeamsTeamIdParams) error {
+	var req apigen.PutOrgsIdTeamsTeamIdJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if len(req.Name) == 0 {
+		return c.Status(400).SendString("团队名称不能为空")
+	}
+	version, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return err
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	team, err := a.svc.RenameTeam(c.Context(), id, teamId, req.Name, version)
+	if err != nil {
+		return teamErrorHandler(c, err)
+	}
+	setETag(c, team.Version)
+	return c.Status(200).JSON(team)
+}
+
+func (a *Controller) DeleteOrgsIdTeamsTeamId(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID, params apigen.DeleteOrgsIdTeamsTeamIdParams) error {
+	version, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return err
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.DeleteTeam(c.Context(), id, teamId, version); err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
+
+func (a *Controller) PostOrgsIdTeamsTeamIdMembers(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID) error {
+	var req apigen.PostOrgsIdTeamsTeamIdMembersJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.AddTeamMember(c.Context(), id, teamId, req.UserId); err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
+
+func (a *Controller) DeleteOrgsIdTeamsTeamIdMembersUserId(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID, userId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.RemoveTeamMember(c.Context(), id, teamId, userId); err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
+
+func (a *Controller) PostOrgsIdTeamsTeamIdRules(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID) error {
+	var req apigen.PostOrgsIdTeamsTeamIdRulesJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if len(req.Rule) == 0 {
+		return c.Status(400).SendString("访问规则不能为空")
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.GrantTeamAccessRule(c.Context(), id, teamId, req.Rule); err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
+
+func (a *Controller) DeleteOrgsIdTeamsTeamIdRulesRule(c *fiber.Ctx, id uuid.UUID, teamId uuid.UUID, rule string) error {
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.RevokeTeamAccessRule(c.Context(), id, teamId, rule); err != nil {
+		return teamErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
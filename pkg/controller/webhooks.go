@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/service"
+)
+
+func webhookErrorHandler(c *fiber.Ctx, err error) error {
+	if errors.Is(err, service.ErrWebhookNotFound) {
+		return c.Status(404).SendString(err.Error())
+	}
+	if errors.Is(err, service.ErrInvalidWebhookURL) || errors.Is(err, service.ErrInvalidWebhookEvent) || errors.Is(err, service.ErrWebhookHostNotAllowed) {
+		return c.Status(400).SendString(err.Error())
+	}
+	return err
+}
+
+func (a *Controller) GetOrgsIdWebhooks(c *fiber.Ctx, id uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	webhooks, err := a.svc.ListWebhooks(c.Context(), id)
+	if err != nil {
+		return err
+	}
+	return c.Status(200).JSON(webhooks)
+}
+
+func (a *Controller) PostOrgsIdWebhooks(c *fiber.Ctx, id uuid.UUID) error {
+	var req apigen.PostOrgsIdWebhooksJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	webhook, err := a.svc.CreateWebhook(c.Context(), id, req.Url, req.Events)
+	if err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(webhook)
+}
+
+func (a *Controller) GetOrgsIdWebhooksWebhookId(c *fiber.Ctx, id uuid.UUID, webhookId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	webhook, err := a.svc.GetWebhook(c.Context(), id, webhookId)
+	if err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(webhook)
+}
+
+func (a *Controller) PutOrgsIdWebhooksWebhookId(c *fiber.Ctx, id uuid.UUID, webhookId uuid.UUID) error {
+	var req apigen.PutOrgsIdWebhooksWebhookIdJSONBody
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(400)
+	}
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	webhook, err := a.svc.UpdateWebhook(c.Context(), id, webhookId, req.Url, req.Events, req.Enabled)
+	if err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(webhook)
+}
+
+func (a *Controller) DeleteOrgsIdWebhooksWebhookId(c *fiber.Ctx, id uuid.UUID, webhookId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	if err := a.svc.DeleteWebhook(c.Context(), id, webhookId); err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.SendStatus(200)
+}
+
+func (a *Controller) GetOrgsIdWebhooksWebhookIdDeliveries(c *fiber.Ctx, id uuid.UUID, webhookId uuid.UUID, params apigen.GetOrgsIdWebhooksWebhookIdDeliveriesParams) error {
+	if _, err := a.checkOrgAccess(c, id, false); err != nil {
+		return err
+	}
+	page, err := parsePageParams(params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		return err
+	}
+	deliveries, err := a.svc.ListWebhookDeliveries(c.Context(), id, webhookId, page)
+	if err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(deliveries)
+}
+
+func (a *Controller) PostOrgsIdWebhooksWebhookIdTest(c *fiber.Ctx, id uuid.UUID, webhookId uuid.UUID) error {
+	if _, err := a.checkOrgAccess(c, id, true); err != nil {
+		return err
+	}
+	delivery, err := a.svc.TestWebhook(c.Context(), id, webhookId)
+	if err != nil {
+		return webhookErrorHandler(c, err)
+	}
+	return c.Status(200).JSON(delivery)
+}
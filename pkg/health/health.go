@@ -0,0 +1,146 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/logger"
+)
+
+var log = logger.NewLogAgent("health")
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// checkTimeout bounds each check, so that a hanging dependency fails the probe instead
+// of timing it out.
+const checkTimeout = 3 * time.Second
+
+var ErrShuttingDown = errors.New("shutting down")
+
+// Check returns an error if the subsystem is not ready to serve.
+type Check func(ctx context.Context) error
+
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Registry holds the readiness checks of the subsystems, it is shared by the apps of a
+// process.
+type Registry struct {
+	mu           sync.RWMutex
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+	// unix nanoseconds of the first SetShuttingDown
+	shuttingDownAt atomic.Int64
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds the check of a subsystem, a check registered under the same name
+// again replaces the former one.
+func (r *Registry) Register(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, c := range r.checks {
+		if c.name == name {
+			r.checks[i].check = check
+			return
+		}
+	}
+	r.checks = append(r.checks, namedCheck{name: name, check: check})
+}
+
+// SetShuttingDown fails the readiness from now on, so that the load balancer stops
+// routing to the process while it drains.
+func (r *Registry) SetShuttingDown() {
+	if r.shuttingDownAt.CompareAndSwap(0, time.Now().UnixNano()) {
+		r.shuttingDown.Store(true)
+		log.Info("readiness is failing for shutdown")
+	}
+}
+
+// Drain fails the readiness and blocks until it has been failing for delay, so that the
+// load balancer has noticed before the listeners are closed. The apps of a process
+// sharing the registry wait for the same delay once.
+func (r *Registry) Drain(delay time.Duration) {
+	r.SetShuttingDown()
+	remaining := time.Until(time.Unix(0, r.shuttingDownAt.Load()).Add(delay))
+	if remaining <= 0 {
+		return
+	}
+	log.Infof("waiting %s for the load balancer to stop routing", remaining.Round(time.Millisecond))
+	time.Sleep(remaining)
+}
+
+// Check runs all checks concurrently.
+func (r *Registry) Check(ctx context.Context) *Report {
+	r.mu.RLock()
+	checks := append([]namedCheck(nil), r.checks...)
+	r.mu.RUnlock()
+
+	report := &Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(checks))}
+	if r.shuttingDown.Load() {
+		report.Status = StatusFail
+		report.Checks["shutdown"] = CheckResult{Status: StatusFail, Error: ErrShuttingDown.Error()}
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, c := range checks {
+		wg.Add(1)
+		go func(c namedCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			result := CheckResult{Status: StatusOK}
+			if err := c.check(checkCtx); err != nil {
+				result = CheckResult{Status: StatusFail, Error: err.Error()}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[c.name] = result
+			if result.Status == StatusFail {
+				report.Status = StatusFail
+			}
+		}(c)
+	}
+	wg.Wait()
+	return report
+}
+
+// Liveness reports that the process is up, it checks nothing so that a failing
+// dependency does not get the process restarted.
+func (r *Registry) Liveness(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(&Report{Status: StatusOK, Checks: map[string]CheckResult{}})
+}
+
+// Readiness reports whether the process can serve, 503 is returned if any check fails.
+func (r *Registry) Readiness(c *fiber.Ctx) error {
+	report := r.Check(c.Context())
+	if report.Status != StatusOK {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(report)
+	}
+	return c.Status(fiber.StatusOK).JSON(report)
+}
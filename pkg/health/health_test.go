@@ -0,0 +1,30 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrain(t *testing.T) {
+	r := NewRegistry()
+	assert.Equal(t, StatusOK, r.Check(context.Background()).Status)
+
+	start := time.Now()
+	r.Drain(100 * time.Millisecond)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	assert.Equal(t, StatusFail, r.Check(context.Background()).Status)
+
+	// the delay is counted from the first time the readiness failed, so the apps
+	// sharing the registry do not wait for it again
+	start = time.Now()
+	r.Drain(100 * time.Millisecond)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+	r = NewRegistry()
+	start = time.Now()
+	r.Drain(0)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,291 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/logger"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+var log = logger.NewLogAgent("jobs")
+
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+const (
+	// DefaultMaxAttempts is how many times a job is run before it is marked failed.
+	DefaultMaxAttempts = 10
+
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+
+	// rescueInterval is how often the jobs abandoned by crashed workers are requeued
+	rescueInterval = time.Minute
+)
+
+var ErrJobExists = errors.New("a job with the unique key is already queued")
+
+// Args are the arguments of a job, they are stored as JSON and the kind selects the
+// handler which runs the job.
+type Args interface {
+	Kind() string
+}
+
+type options struct {
+	runAt       *time.Time
+	uniqueKey   *string
+	maxAttempts int32
+}
+
+type Option func(*options)
+
+// RunAt schedules the job to run no earlier than t.
+func RunAt(t time.Time) Option {
+	return func(o *options) {
+		o.runAt = &t
+	}
+}
+
+// Delay schedules the job to run after d.
+func Delay(d time.Duration) Option {
+	return RunAt(time.Now().Add(d))
+}
+
+// UniqueKey makes Enqueue return ErrJobExists while a job of the same kind with the key
+// is pending or running.
+func UniqueKey(key string) Option {
+	return func(o *options) {
+		o.uniqueKey = &key
+	}
+}
+
+func MaxAttempts(n int32) Option {
+	return func(o *options) {
+		o.maxAttempts = n
+	}
+}
+
+// Enqueue adds the job to the queue and returns its ID. Called with the model of a
+// transaction, the job is only run if the transaction commits.
+func Enqueue(ctx context.Context, m model.ModelInterface, args Args, opts ...Option) (int64, error) {
+	o := options{maxAttempts: DefaultMaxAttempts}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to marshal job %s", args.Kind())
+	}
+	id, err := m.InsertJob(ctx, querier.InsertJobParams{
+		Kind:        args.Kind(),
+		Args:        raw,
+		UniqueKey:   o.uniqueKey,
+		MaxAttempts: o.maxAttempts,
+		RunAt:       o.runAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrJobExists
+		}
+		return 0, errors.Wrapf(err, "failed to insert job %s", args.Kind())
+	}
+	return id, nil
+}
+
+type handler func(ctx context.Context, args []byte) error
+
+// Worker claims the due jobs from the queue and runs them with the registered handlers.
+// Any number of workers can share the queue.
+type Worker struct {
+	m            model.ModelInterface
+	handlers     map[string]handler
+	concurrency  int
+	pollInterval time.Duration
+	jobTimeout   time.Duration
+	now          func() time.Time
+}
+
+func NewWorker(cfg *config.Config, m model.ModelInterface) *Worker {
+	return &Worker{
+		m:            m,
+		handlers:     make(map[string]handler),
+		concurrency:  cfg.Worker.Concurrency,
+		pollInterval: cfg.Worker.PollInterval,
+		jobTimeout:   cfg.Worker.JobTimeout,
+		now:          time.Now,
+	}
+}
+
+// Register registers the handler of the jobs of kind T, it must be called before Run.
+// Jobs are retried if the handler fails, so it must be idempotent.
+func Register[T Args](w *Worker, h func(ctx context.Context, args T) error) {
+	var zero T
+	w.handlers[zero.Kind()] = func(ctx context.Context, raw []byte) error {
+		var args T
+		if err := json.Unmarshal(raw, &args); err != nil {
+			return errors.Wrap(err, "failed to unmarshal job args")
+		}
+		return h(ctx, args)
+	}
+}
+
+// Run runs the jobs until ctx is done, it returns once the running jobs are finished.
+func (w *Worker) Run(ctx context.Context) {
+	// the jobs are not run on behalf of an org
+	ctx = model.WithBypassRLS(ctx)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.work(ctx)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.rescue(ctx)
+	}()
+	wg.Wait()
+}
+
+func (w *Worker) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		// the claimed job is finished even if the worker is stopping, it is still
+		// bounded by the job timeout
+		ran, err := w.RunNext(context.WithoutCancel(ctx))
+		if err != nil {
+			log.Errorf("failed to run job: %s", err.Error())
+		}
+		if ran && err == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// rescue requeues the jobs whose worker is gone, they are retried unless they are out
+// of attempts.
+func (w *Worker) rescue(ctx context.Context) {
+	ticker := time.NewTicker(rescueInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		rescued, err := w.m.RescueStuckJobs(ctx, w.now().Add(-w.jobTimeout))
+		if err != nil {
+			log.Errorf("failed to rescue stuck jobs: %s", err.Error())
+			continue
+		}
+		if rescued > 0 {
+			log.Warnf("rescued %d stuck jobs", rescued)
+		}
+	}
+}
+
+// RunNext claims the next due job and runs it, false is returned if there is none.
+// The job is only finalized if the worker still holds the claim, it may have been
+// rescued and claimed by another worker if it ran past the job timeout.
+func (w *Worker) RunNext(ctx context.Context) (bool, error) {
+	job, err := w.m.ClaimNextJob(ctx, w.now())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to claim job")
+	}
+	// the lock time set by the claim identifies it
+	claimedAt := *job.LockedAt
+
+	if err := w.runJob(ctx, job); err != nil {
+		if job.Attempts >= job.MaxAttempts {
+			log.Errorf("job %d %s failed after %d attempts: %s", job.ID, job.Kind, job.Attempts, err.Error())
+			updated, err := w.m.FailJob(ctx, querier.FailJobParams{
+				ID:        job.ID,
+				LastError: utils.Ptr(err.Error()),
+				ClaimedAt: claimedAt,
+			})
+			if err != nil {
+				return true, errors.Wrap(err, "failed to mark job failed")
+			}
+			checkClaim(job, updated)
+			return true, nil
+		}
+		log.Warnf("job %d %s failed (%d/%d): %s", job.ID, job.Kind, job.Attempts, job.MaxAttempts, err.Error())
+		updated, err := w.m.RetryJob(ctx, querier.RetryJobParams{
+			ID:        job.ID,
+			LastError: utils.Ptr(err.Error()),
+			RunAt:     w.now().Add(retryDelay(job.Attempts)),
+			ClaimedAt: claimedAt,
+		})
+		if err != nil {
+			return true, errors.Wrap(err, "failed to retry job")
+		}
+		checkClaim(job, updated)
+		return true, nil
+	}
+	updated, err := w.m.CompleteJob(ctx, querier.CompleteJobParams{
+		ID:        job.ID,
+		ClaimedAt: claimedAt,
+	})
+	if err != nil {
+		return true, errors.Wrap(err, "failed to complete job")
+	}
+	checkClaim(job, updated)
+	return true, nil
+}
+
+// checkClaim logs the job left unfinalized as the claim was lost, the job is up to the
+// rescue and the worker which claimed it since.
+func checkClaim(job *querier.Job, updated int64) {
+	if updated == 0 {
+		log.Warnf("job %d %s lost its claim while running, it is not finalized", job.ID, job.Kind)
+	}
+}
+
+// runJob runs the handler of the job within the job timeout, panics are returned as
+// errors so that the job is retried.
+func (w *Worker) runJob(ctx context.Context, job *querier.Job) (err error) {
+	h, ok := w.handlers[job.Kind]
+	if !ok {
+		return errors.Errorf("no handler registered for job %s", job.Kind)
+	}
+	ctx, cancel := context.WithTimeout(ctx, w.jobTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return h(ctx, job.Args)
+}
+
+// retryDelay doubles the delay on every failed attempt.
+func retryDelay(attempts int32) time.Duration {
+	delay := retryBaseDelay << (attempts - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
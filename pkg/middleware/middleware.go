@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model"
 	"github.com/xich-dev/go-starter/pkg/model/querier"
 	"github.com/xich-dev/go-starter/pkg/utils"
 )
@@ -30,6 +32,12 @@ type User struct {
 	AccessRules map[string]struct{}
 }
 
+// UserChecker tells whether the user of a token still exists.
+type UserChecker interface {
+	// IsUserActive returns false if the user has been deleted.
+	IsUserActive(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
 type Middleware struct {
 	jwtMiddleware func(*fiber.Ctx) error
 	jwtSecret     []byte
@@ -56,7 +64,9 @@ func (m *Middleware) CreateToken(user *querier.User, rules []string) (string, er
 	return token.SignedString([]byte(m.jwtSecret))
 }
 
-func (m *Middleware) Auth() fiber.Handler {
+// Auth verifies the token and rejects it if its user has been deleted, as the token
+// stays valid until it expires.
+func (m *Middleware) Auth(users UserChecker) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if err := m.jwtMiddleware(c); err != nil {
 			return c.Status(403).SendString(err.Error())
@@ -66,6 +76,16 @@ func (m *Middleware) Auth() fiber.Handler {
 			return c.Status(401).SendString(err.Error())
 		}
 		c.Locals(UserContextKey, user)
+		// scope the queries of the request to the org of the user
+		c.Locals(model.OrgContextKey{}, user.OrgID)
+
+		active, err := users.IsUserActive(c.Context(), user.Id)
+		if err != nil {
+			return errors.Wrap(err, "failed to check user")
+		}
+		if !active {
+			return c.Status(401).SendString("用户不存在")
+		}
 		return c.Next()
 	}
 }
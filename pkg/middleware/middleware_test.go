@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+type fakeUserChecker map[uuid.UUID]bool
+
+func (f fakeUserChecker) IsUserActive(ctx context.Context, userID uuid.UUID) (bool, error) {
+	active, ok := f[userID]
+	if !ok {
+		return false, errors.New("connection refused")
+	}
+	return active, nil
+}
+
+func TestAuth(t *testing.T) {
+	m, err := NewMiddleware(&config.Config{Jwt: config.Jwt{Secret: "secret"}})
+	require.NoError(t, err)
+
+	var (
+		activeUser  = &querier.User{ID: uuid.Must(uuid.NewRandom()), OrgID: uuid.Must(uuid.NewRandom())}
+		deletedUser = &querier.User{ID: uuid.Must(uuid.NewRandom()), OrgID: uuid.Must(uuid.NewRandom())}
+		brokenUser  = &querier.User{ID: uuid.Must(uuid.NewRandom()), OrgID: uuid.Must(uuid.NewRandom())}
+		users       = fakeUserChecker{activeUser.ID: true, deletedUser.ID: false}
+	)
+
+	app := fiber.New()
+	app.Get("/", m.Auth(users), func(c *fiber.Ctx) error {
+		user, err := GetUser(c)
+		if err != nil {
+			return err
+		}
+		return c.SendString(user.Id.String())
+	})
+
+	testCases := []struct {
+		name     string
+		user     *querier.User
+		expected int
+	}{
+		{name: "active", user: activeUser, expected: fiber.StatusOK},
+		{name: "deleted", user: deletedUser, expected: fiber.StatusUnauthorized},
+		{name: "check failed", user: brokenUser, expected: fiber.StatusInternalServerError},
+		{name: "no token", user: nil, expected: fiber.StatusUnauthorized},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if testCase.user != nil {
+				token, err := m.CreateToken(testCase.user, nil)
+				require.NoError(t, err)
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, resp.StatusCode)
+		})
+	}
+}
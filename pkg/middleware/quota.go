@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// QuotaConsumer meters the usage of orgs.
+type QuotaConsumer interface {
+	// ConsumeQuota records one usage of the metric, false is returned if the org has used up its quota.
+	ConsumeQuota(ctx context.Context, orgID uuid.UUID, metric string) (bool, error)
+}
+
+// Quota rejects the request if the org of the user has used up the quota of the metric,
+// it must be used after Auth. The requests for which skip returns true are neither
+// metered nor rejected.
+func (m *Middleware) Quota(consumer QuotaConsumer, metric string, skip func(c *fiber.Ctx) bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if skip != nil && skip(c) {
+			return c.Next()
+		}
+		user, err := GetUser(c)
+		if err != nil {
+			return c.Status(403).SendString(err.Error())
+		}
+		ok, err := consumer.ConsumeQuota(c.Context(), user.OrgID, metric)
+		if err != nil {
+			return errors.Wrap(err, "failed to consume quota")
+		}
+		if !ok {
+			return c.Status(fiber.StatusTooManyRequests).SendString("已超出套餐额度")
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+// buildDSN returns the DSN of the primary in URL format, credentials are escaped so
+// passwords can contain any character.
+func buildDSN(cfg *config.Pg) string {
+	if len(cfg.Dsn) > 0 {
+		return cfg.Dsn
+	}
+	query := url.Values{}
+	query.Set("connect_timeout", strconv.Itoa(cfg.ConnectTimeout))
+	query.Set("timezone", cfg.Timezone)
+	for key, value := range map[string]string{
+		"sslmode":     cfg.SslMode,
+		"sslrootcert": cfg.SslRootCert,
+		"sslcert":     cfg.SslCert,
+		"sslkey":      cfg.SslKey,
+	} {
+		if len(value) > 0 {
+			query.Set(key, value)
+		}
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     "/" + cfg.Db,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// redactDSN hides the password in the DSN for logging, DSNs not in URL format are
+// hidden entirely as the password cannot be told apart.
+func redactDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || len(u.Scheme) == 0 {
+		return "<invalid dsn>"
+	}
+	return u.Redacted()
+}
+
+// migrateURL returns the URL of the DSN for the pgx v5 driver of golang-migrate.
+func migrateURL(dsn string) (string, error) {
+	for _, scheme := range []string{"postgres://", "postgresql://"} {
+		if strings.HasPrefix(dsn, scheme) {
+			return "pgx5://" + strings.TrimPrefix(dsn, scheme), nil
+		}
+	}
+	return "", errors.Errorf("dsn must be in URL format: %s", redactDSN(dsn))
+}
+
+// newPoolConfig parses the DSN and applies the pool and session settings, the settings
+// apply to the pools of both the primary and the replicas.
+func newPoolConfig(cfg *config.Pg, dsn string) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pgxpool config: %s", redactDSN(dsn))
+	}
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if cfg.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = fmt.Sprintf("%d", cfg.StatementTimeout.Milliseconds())
+	}
+	if len(cfg.ApplicationName) > 0 {
+		poolConfig.ConnConfig.RuntimeParams["application_name"] = cfg.ApplicationName
+	}
+	poolConfig.BeforeAcquire = setSessionOrg
+	return poolConfig, nil
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+// AdvisoryLock is a session level advisory lock held on a dedicated connection.
+type AdvisoryLock interface {
+	// Unlock releases the lock and returns the connection to the pool.
+	Unlock()
+}
+
+type advisoryLock struct {
+	conn *pgxpool.Conn
+	key  string
+}
+
+func (l *advisoryLock) Unlock() {
+	ctx := context.Background()
+	if _, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock(hashtext($1))", l.key); err != nil {
+		// the lock is released along with the session
+		log.Warnf("failed to release advisory lock %s, closing the connection: %s", l.key, err.Error())
+		conn := l.conn.Hijack()
+		conn.Close(ctx)
+		return
+	}
+	l.conn.Release()
+}
+
+func (m *Model) TryAdvisoryLock(ctx context.Context, key string) (AdvisoryLock, bool, error) {
+	if m.inTransaction {
+		return nil, false, errors.New("cannot take a session lock in a transaction")
+	}
+	conn, err := m.p.Acquire(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to acquire connection")
+	}
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", key).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, false, errors.Wrapf(err, "failed to lock %s", key)
+	}
+	if !locked {
+		conn.Release()
+		return nil, false, nil
+	}
+	return &advisoryLock{conn: conn, key: key}, true, nil
+}
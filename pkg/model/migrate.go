@@ -0,0 +1,209 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	migrations "github.com/xich-dev/go-starter/sql/migrations"
+)
+
+// migrationLockKey is the key of the advisory lock held while migrating, so the
+// migrations are run by one process at a time when several instances start together.
+const migrationLockKey int64 = 0x78696373_6d696772
+
+type MigrationStatus struct {
+	// Version is the current version, 0 if no migration is applied
+	Version uint
+	// Dirty means the last migration failed halfway and must be fixed by hand,
+	// then recorded with Force
+	Dirty bool
+	// Latest is the version of the last migration available
+	Latest uint
+}
+
+type Migrator struct {
+	m   *migrate.Migrate
+	src source.Driver
+	dsn string
+}
+
+// openMigrationSource opens the migrations embedded in the binary, or the migrations
+// in dir if it is set, which is handy in development.
+func openMigrationSource(dir string) (source.Driver, string, error) {
+	if len(dir) == 0 {
+		src, err := iofs.New(migrations.FS, ".")
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to open embedded migrations")
+		}
+		return src, "iofs", nil
+	}
+	src, err := source.Open(fmt.Sprintf("file://%s", dir))
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to open migrations in %s", dir)
+	}
+	return src, "file", nil
+}
+
+type migrateLogger struct{}
+
+func (l *migrateLogger) Printf(format string, v ...any) {
+	log.Infof(format, v...)
+}
+
+func (l *migrateLogger) Verbose() bool {
+	return false
+}
+
+func NewMigrator(cfg *config.Config) (*Migrator, error) {
+	dsn := buildDSN(&cfg.Pg)
+	migrateDSN, err := migrateURL(dsn)
+	if err != nil {
+		return nil, err
+	}
+	src, srcName, err := openMigrationSource(cfg.Pg.Migration)
+	if err != nil {
+		return nil, err
+	}
+	m, err := migrate.NewWithSourceInstance(srcName, src, migrateDSN)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init migrate")
+	}
+	m.Log = &migrateLogger{}
+	return &Migrator{m: m, src: src, dsn: dsn}, nil
+}
+
+// withLock runs f holding the migration advisory lock on a dedicated connection,
+// the lock is released when the connection is closed even if the process crashes.
+func (m *Migrator) withLock(ctx context.Context, f func() error) error {
+	conn, err := pgx.Connect(ctx, m.dsn)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to database")
+	}
+	defer conn.Close(context.Background())
+
+	log.Info("waiting for the migration lock")
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return errors.Wrap(err, "failed to acquire the migration lock")
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			log.Warnf("failed to release the migration lock: %s", err.Error())
+		}
+	}()
+	return f()
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func() error {
+		return errors.Wrap(ignoreNoChange(m.m.Up()), "failed to migrate up")
+	})
+}
+
+// Down rolls back the last steps migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return errors.Errorf("steps must be positive, got %d", steps)
+	}
+	return m.withLock(ctx, func() error {
+		return errors.Wrap(ignoreNoChange(m.m.Steps(-steps)), "failed to migrate down")
+	})
+}
+
+// Goto migrates up or down to the version.
+func (m *Migrator) Goto(ctx context.Context, version uint) error {
+	return m.withLock(ctx, func() error {
+		return errors.Wrapf(ignoreNoChange(m.m.Migrate(version)), "failed to migrate to version %d", version)
+	})
+}
+
+// Force records the version without running any migration, it is used to clear the
+// dirty state after fixing a failed migration by hand.
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	return m.withLock(ctx, func() error {
+		return errors.Wrapf(m.m.Force(version), "failed to force version %d", version)
+	})
+}
+
+func (m *Migrator) Status() (*MigrationStatus, error) {
+	var status MigrationStatus
+	version, dirty, err := m.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, errors.Wrap(err, "failed to get version")
+	}
+	status.Version, status.Dirty = version, dirty
+
+	if status.Latest, err = latestVersion(m.src); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// latestVersion returns the version of the last migration of src, 0 if there is none.
+func latestVersion(src source.Driver) (uint, error) {
+	latest, err := src.First()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to read migrations")
+	}
+	for {
+		next, err := src.Next(latest)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return latest, nil
+			}
+			return 0, errors.Wrap(err, "failed to read migrations")
+		}
+		latest = next
+	}
+}
+
+// latestMigrationVersion returns the version of the last migration available, the
+// migrations are read from dir if it is set.
+func latestMigrationVersion(dir string) (uint, error) {
+	src, _, err := openMigrationSource(dir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	return latestVersion(src)
+}
+
+// GetMigrationStatus reads the version recorded by the migrations from the database,
+// it is cheap enough for the readiness checks.
+func (m *Model) GetMigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	status := MigrationStatus{Latest: m.latestMigration}
+	var version int64
+	err := m.p.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &status.Dirty)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to query migration version")
+	}
+	status.Version = uint(version)
+	return &status, nil
+}
+
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	if srcErr != nil {
+		return errors.Wrap(srcErr, "failed to close migration source")
+	}
+	return errors.Wrap(dbErr, "failed to close migration database")
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMigrations(t *testing.T, names ...string) string {
+	dir := t.TempDir()
+	for _, name := range names {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0o644))
+	}
+	return dir
+}
+
+func TestLatestMigrationVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		files    []string
+		expected uint
+	}{
+		{name: "empty", files: nil, expected: 0},
+		{name: "one", files: []string{"0001_init.up.sql", "0001_init.down.sql"}, expected: 1},
+		{
+			name: "gap",
+			files: []string{
+				"0001_init.up.sql", "0001_init.down.sql",
+				"0002_orgs.up.sql", "0002_orgs.down.sql",
+				"0010_teams.up.sql", "0010_teams.down.sql",
+			},
+			expected: 10,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			latest, err := latestMigrationVersion(writeMigrations(t, testCase.files...))
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, latest)
+		})
+	}
+
+	_, err := latestMigrationVersion(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestMigratorDownSteps(t *testing.T) {
+	m := &Migrator{}
+	for _, steps := range []int{0, -1} {
+		assert.Error(t, m.Down(context.Background(), steps))
+	}
+}
+
+func TestIgnoreNoChange(t *testing.T) {
+	assert.NoError(t, ignoreNoChange(nil))
+	assert.NoError(t, ignoreNoChange(migrate.ErrNoChange))
+	assert.NoError(t, ignoreNoChange(errors.Wrap(migrate.ErrNoChange, "failed to migrate up")))
+
+	err := errors.New("dirty database")
+	assert.Equal(t, err, ignoreNoChange(err))
+}
+
+func TestLatestMigrationVersionEmbedded(t *testing.T) {
+	embedded, err := latestMigrationVersion("")
+	require.NoError(t, err)
+	assert.Positive(t, embedded)
+
+	// the binary embeds the migrations of the repo
+	fromDir, err := latestMigrationVersion("../../sql/migrations")
+	require.NoError(t, err)
+	assert.Equal(t, fromDir, embedded)
+}
@@ -15,6 +15,6 @@ func NewExtendedMockModelInterface(ctrl *gomock.Controller) *ExtendMockModel {
 	return &ExtendMockModel{mock}
 }
 
-func (e *ExtendMockModel) RunTransaction(ctx context.Context, f func(model ModelInterface) error) error {
+func (e *ExtendMockModel) RunTransaction(ctx context.Context, f func(model ModelInterface) error, opts ...TxOption) error {
 	return f(e)
 }
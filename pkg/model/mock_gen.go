@@ -7,6 +7,7 @@ package model
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,48 @@ func (m *MockModelInterface) EXPECT() *MockModelInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddRoleAccessRules mocks base method.
+func (m *MockModelInterface) AddRoleAccessRules(ctx context.Context, arg querier.AddRoleAccessRulesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleAccessRules", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoleAccessRules indicates an expected call of AddRoleAccessRules.
+func (mr *MockModelInterfaceMockRecorder) AddRoleAccessRules(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleAccessRules", reflect.TypeOf((*MockModelInterface)(nil).AddRoleAccessRules), ctx, arg)
+}
+
+// AddTeamAccessRule mocks base method.
+func (m *MockModelInterface) AddTeamAccessRule(ctx context.Context, arg querier.AddTeamAccessRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamAccessRule", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTeamAccessRule indicates an expected call of AddTeamAccessRule.
+func (mr *MockModelInterfaceMockRecorder) AddTeamAccessRule(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamAccessRule", reflect.TypeOf((*MockModelInterface)(nil).AddTeamAccessRule), ctx, arg)
+}
+
+// AddTeamMember mocks base method.
+func (m *MockModelInterface) AddTeamMember(ctx context.Context, arg querier.AddTeamMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockModelInterfaceMockRecorder) AddTeamMember(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockModelInterface)(nil).AddTeamMember), ctx, arg)
+}
+
 // AddUserAccessRule mocks base method.
 func (m *MockModelInterface) AddUserAccessRule(ctx context.Context, arg querier.AddUserAccessRuleParams) error {
 	m.ctrl.T.Helper()
@@ -47,185 +90,1204 @@ func (m *MockModelInterface) AddUserAccessRule(ctx context.Context, arg querier.
 // AddUserAccessRule indicates an expected call of AddUserAccessRule.
 func (mr *MockModelInterfaceMockRecorder) AddUserAccessRule(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserAccessRule", reflect.TypeOf((*MockModelInterface)(nil).AddUserAccessRule), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserAccessRule", reflect.TypeOf((*MockModelInterface)(nil).AddUserAccessRule), ctx, arg)
+}
+
+// AddUserRole mocks base method.
+func (m *MockModelInterface) AddUserRole(ctx context.Context, arg querier.AddUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserRole", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserRole indicates an expected call of AddUserRole.
+func (mr *MockModelInterfaceMockRecorder) AddUserRole(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserRole", reflect.TypeOf((*MockModelInterface)(nil).AddUserRole), ctx, arg)
+}
+
+// CancelOrgSubscription mocks base method.
+func (m *MockModelInterface) CancelOrgSubscription(ctx context.Context, arg querier.CancelOrgSubscriptionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrgSubscription", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrgSubscription indicates an expected call of CancelOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) CancelOrgSubscription(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).CancelOrgSubscription), ctx, arg)
+}
+
+// ClaimNextJob mocks base method.
+func (m *MockModelInterface) ClaimNextJob(ctx context.Context, now time.Time) (*querier.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNextJob", ctx, now)
+	ret0, _ := ret[0].(*querier.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNextJob indicates an expected call of ClaimNextJob.
+func (mr *MockModelInterfaceMockRecorder) ClaimNextJob(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNextJob", reflect.TypeOf((*MockModelInterface)(nil).ClaimNextJob), ctx, now)
+}
+
+// ClaimNextOutboxEvent mocks base method.
+func (m *MockModelInterface) ClaimNextOutboxEvent(ctx context.Context, arg querier.ClaimNextOutboxEventParams) (*querier.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNextOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(*querier.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNextOutboxEvent indicates an expected call of ClaimNextOutboxEvent.
+func (mr *MockModelInterfaceMockRecorder) ClaimNextOutboxEvent(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNextOutboxEvent", reflect.TypeOf((*MockModelInterface)(nil).ClaimNextOutboxEvent), ctx, arg)
+}
+
+// CompleteJob mocks base method.
+func (m *MockModelInterface) CompleteJob(ctx context.Context, arg querier.CompleteJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteJob", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteJob indicates an expected call of CompleteJob.
+func (mr *MockModelInterfaceMockRecorder) CompleteJob(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteJob", reflect.TypeOf((*MockModelInterface)(nil).CompleteJob), ctx, arg)
+}
+
+// ConsumeUsage mocks base method.
+func (m *MockModelInterface) ConsumeUsage(ctx context.Context, arg querier.ConsumeUsageParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeUsage", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeUsage indicates an expected call of ConsumeUsage.
+func (mr *MockModelInterfaceMockRecorder) ConsumeUsage(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeUsage", reflect.TypeOf((*MockModelInterface)(nil).ConsumeUsage), ctx, arg)
+}
+
+// CountOtherOrgMembers mocks base method.
+func (m *MockModelInterface) CountOtherOrgMembers(ctx context.Context, arg querier.CountOtherOrgMembersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOtherOrgMembers", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOtherOrgMembers indicates an expected call of CountOtherOrgMembers.
+func (mr *MockModelInterfaceMockRecorder) CountOtherOrgMembers(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOtherOrgMembers", reflect.TypeOf((*MockModelInterface)(nil).CountOtherOrgMembers), ctx, arg)
+}
+
+// CreateAccessRuleIfNotExists mocks base method.
+func (m *MockModelInterface) CreateAccessRuleIfNotExists(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessRuleIfNotExists", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccessRuleIfNotExists indicates an expected call of CreateAccessRuleIfNotExists.
+func (mr *MockModelInterfaceMockRecorder) CreateAccessRuleIfNotExists(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRuleIfNotExists", reflect.TypeOf((*MockModelInterface)(nil).CreateAccessRuleIfNotExists), ctx, name)
+}
+
+// CreateLedgerEntry mocks base method.
+func (m *MockModelInterface) CreateLedgerEntry(ctx context.Context, arg querier.CreateLedgerEntryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerEntry", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLedgerEntry indicates an expected call of CreateLedgerEntry.
+func (mr *MockModelInterfaceMockRecorder) CreateLedgerEntry(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerEntry", reflect.TypeOf((*MockModelInterface)(nil).CreateLedgerEntry), ctx, arg)
+}
+
+// CreateLedgerTransaction mocks base method.
+func (m *MockModelInterface) CreateLedgerTransaction(ctx context.Context, arg querier.CreateLedgerTransactionParams) (*querier.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerTransaction", ctx, arg)
+	ret0, _ := ret[0].(*querier.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLedgerTransaction indicates an expected call of CreateLedgerTransaction.
+func (mr *MockModelInterfaceMockRecorder) CreateLedgerTransaction(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerTransaction", reflect.TypeOf((*MockModelInterface)(nil).CreateLedgerTransaction), ctx, arg)
+}
+
+// CreateOrg mocks base method.
+func (m *MockModelInterface) CreateOrg(ctx context.Context, name string) (*querier.Org, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, name)
+	ret0, _ := ret[0].(*querier.Org)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg.
+func (mr *MockModelInterfaceMockRecorder) CreateOrg(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockModelInterface)(nil).CreateOrg), ctx, name)
+}
+
+// CreateOrgStatement mocks base method.
+func (m *MockModelInterface) CreateOrgStatement(ctx context.Context, arg querier.CreateOrgStatementParams) (*querier.OrgStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrgStatement", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrgStatement indicates an expected call of CreateOrgStatement.
+func (mr *MockModelInterfaceMockRecorder) CreateOrgStatement(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrgStatement", reflect.TypeOf((*MockModelInterface)(nil).CreateOrgStatement), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockModelInterface) CreateOutboxEvent(ctx context.Context, arg querier.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockModelInterfaceMockRecorder) CreateOutboxEvent(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockModelInterface)(nil).CreateOutboxEvent), ctx, arg)
+}
+
+// CreateRechargeOrder mocks base method.
+func (m *MockModelInterface) CreateRechargeOrder(ctx context.Context, arg querier.CreateRechargeOrderParams) (*querier.RechargeOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRechargeOrder", ctx, arg)
+	ret0, _ := ret[0].(*querier.RechargeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRechargeOrder indicates an expected call of CreateRechargeOrder.
+func (mr *MockModelInterfaceMockRecorder) CreateRechargeOrder(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRechargeOrder", reflect.TypeOf((*MockModelInterface)(nil).CreateRechargeOrder), ctx, arg)
+}
+
+// CreateScheduledTaskRun mocks base method.
+func (m *MockModelInterface) CreateScheduledTaskRun(ctx context.Context, arg querier.CreateScheduledTaskRunParams) (*querier.ScheduledTaskRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTaskRun", ctx, arg)
+	ret0, _ := ret[0].(*querier.ScheduledTaskRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTaskRun indicates an expected call of CreateScheduledTaskRun.
+func (mr *MockModelInterfaceMockRecorder) CreateScheduledTaskRun(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTaskRun", reflect.TypeOf((*MockModelInterface)(nil).CreateScheduledTaskRun), ctx, arg)
+}
+
+// CreateTeam mocks base method.
+func (m *MockModelInterface) CreateTeam(ctx context.Context, arg querier.CreateTeamParams) (*querier.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, arg)
+	ret0, _ := ret[0].(*querier.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockModelInterfaceMockRecorder) CreateTeam(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockModelInterface)(nil).CreateTeam), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockModelInterface) CreateUser(ctx context.Context, arg querier.CreateUserParams) (*querier.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(*querier.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockModelInterfaceMockRecorder) CreateUser(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockModelInterface)(nil).CreateUser), ctx, arg)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockModelInterface) CreateWebhookDelivery(ctx context.Context, arg querier.CreateWebhookDeliveryParams) (*querier.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, arg)
+	ret0, _ := ret[0].(*querier.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockModelInterfaceMockRecorder) CreateWebhookDelivery(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockModelInterface)(nil).CreateWebhookDelivery), ctx, arg)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockModelInterface) CreateWebhookEndpoint(ctx context.Context, arg querier.CreateWebhookEndpointParams) (*querier.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", ctx, arg)
+	ret0, _ := ret[0].(*querier.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockModelInterfaceMockRecorder) CreateWebhookEndpoint(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockModelInterface)(nil).CreateWebhookEndpoint), ctx, arg)
+}
+
+// DeleteOrgSubscription mocks base method.
+func (m *MockModelInterface) DeleteOrgSubscription(ctx context.Context, orgID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrgSubscription", ctx, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrgSubscription indicates an expected call of DeleteOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) DeleteOrgSubscription(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).DeleteOrgSubscription), ctx, orgID)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockModelInterface) DeleteTeam(ctx context.Context, arg querier.DeleteTeamParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockModelInterfaceMockRecorder) DeleteTeam(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockModelInterface)(nil).DeleteTeam), ctx, arg)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockModelInterface) DeleteWebhookEndpoint(ctx context.Context, arg querier.DeleteWebhookEndpointParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockModelInterfaceMockRecorder) DeleteWebhookEndpoint(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockModelInterface)(nil).DeleteWebhookEndpoint), ctx, arg)
+}
+
+// FailJob mocks base method.
+func (m *MockModelInterface) FailJob(ctx context.Context, arg querier.FailJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailJob", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailJob indicates an expected call of FailJob.
+func (mr *MockModelInterfaceMockRecorder) FailJob(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailJob", reflect.TypeOf((*MockModelInterface)(nil).FailJob), ctx, arg)
+}
+
+// GetAccessRule mocks base method.
+func (m *MockModelInterface) GetAccessRule(ctx context.Context, name string) (*querier.AccessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRule", ctx, name)
+	ret0, _ := ret[0].(*querier.AccessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRule indicates an expected call of GetAccessRule.
+func (mr *MockModelInterfaceMockRecorder) GetAccessRule(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRule", reflect.TypeOf((*MockModelInterface)(nil).GetAccessRule), ctx, name)
+}
+
+// GetDueOrgSubscriptionForUpdate mocks base method.
+func (m *MockModelInterface) GetDueOrgSubscriptionForUpdate(ctx context.Context, arg querier.GetDueOrgSubscriptionForUpdateParams) (*querier.OrgSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueOrgSubscriptionForUpdate", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueOrgSubscriptionForUpdate indicates an expected call of GetDueOrgSubscriptionForUpdate.
+func (mr *MockModelInterfaceMockRecorder) GetDueOrgSubscriptionForUpdate(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueOrgSubscriptionForUpdate", reflect.TypeOf((*MockModelInterface)(nil).GetDueOrgSubscriptionForUpdate), ctx, arg)
+}
+
+// GetMigrationStatus mocks base method.
+func (m *MockModelInterface) GetMigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationStatus", ctx)
+	ret0, _ := ret[0].(*MigrationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationStatus indicates an expected call of GetMigrationStatus.
+func (mr *MockModelInterfaceMockRecorder) GetMigrationStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationStatus", reflect.TypeOf((*MockModelInterface)(nil).GetMigrationStatus), ctx)
+}
+
+// GetOrgBalance mocks base method.
+func (m *MockModelInterface) GetOrgBalance(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgBalance", ctx, orgID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgBalance indicates an expected call of GetOrgBalance.
+func (mr *MockModelInterfaceMockRecorder) GetOrgBalance(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgBalance", reflect.TypeOf((*MockModelInterface)(nil).GetOrgBalance), ctx, orgID)
+}
+
+// GetOrgBalanceForUpdate mocks base method.
+func (m *MockModelInterface) GetOrgBalanceForUpdate(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgBalanceForUpdate", ctx, orgID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgBalanceForUpdate indicates an expected call of GetOrgBalanceForUpdate.
+func (mr *MockModelInterfaceMockRecorder) GetOrgBalanceForUpdate(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgBalanceForUpdate", reflect.TypeOf((*MockModelInterface)(nil).GetOrgBalanceForUpdate), ctx, orgID)
+}
+
+// GetOrgInfoByOrgId mocks base method.
+func (m *MockModelInterface) GetOrgInfoByOrgId(ctx context.Context, id uuid.UUID) (*querier.Org, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgInfoByOrgId", ctx, id)
+	ret0, _ := ret[0].(*querier.Org)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgInfoByOrgId indicates an expected call of GetOrgInfoByOrgId.
+func (mr *MockModelInterfaceMockRecorder) GetOrgInfoByOrgId(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgInfoByOrgId", reflect.TypeOf((*MockModelInterface)(nil).GetOrgInfoByOrgId), ctx, id)
+}
+
+// GetOrgLedgerBalanceBefore mocks base method.
+func (m *MockModelInterface) GetOrgLedgerBalanceBefore(ctx context.Context, arg querier.GetOrgLedgerBalanceBeforeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgLedgerBalanceBefore", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgLedgerBalanceBefore indicates an expected call of GetOrgLedgerBalanceBefore.
+func (mr *MockModelInterfaceMockRecorder) GetOrgLedgerBalanceBefore(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgLedgerBalanceBefore", reflect.TypeOf((*MockModelInterface)(nil).GetOrgLedgerBalanceBefore), ctx, arg)
+}
+
+// GetOrgLedgerSummary mocks base method.
+func (m *MockModelInterface) GetOrgLedgerSummary(ctx context.Context, arg querier.GetOrgLedgerSummaryParams) ([]*querier.GetOrgLedgerSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgLedgerSummary", ctx, arg)
+	ret0, _ := ret[0].([]*querier.GetOrgLedgerSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgLedgerSummary indicates an expected call of GetOrgLedgerSummary.
+func (mr *MockModelInterfaceMockRecorder) GetOrgLedgerSummary(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgLedgerSummary", reflect.TypeOf((*MockModelInterface)(nil).GetOrgLedgerSummary), ctx, arg)
+}
+
+// GetOrgStatement mocks base method.
+func (m *MockModelInterface) GetOrgStatement(ctx context.Context, arg querier.GetOrgStatementParams) (*querier.OrgStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgStatement", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgStatement indicates an expected call of GetOrgStatement.
+func (mr *MockModelInterfaceMockRecorder) GetOrgStatement(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgStatement", reflect.TypeOf((*MockModelInterface)(nil).GetOrgStatement), ctx, arg)
+}
+
+// GetOrgSubscription mocks base method.
+func (m *MockModelInterface) GetOrgSubscription(ctx context.Context, orgID uuid.UUID) (*querier.OrgSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgSubscription", ctx, orgID)
+	ret0, _ := ret[0].(*querier.OrgSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgSubscription indicates an expected call of GetOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) GetOrgSubscription(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).GetOrgSubscription), ctx, orgID)
+}
+
+// GetOrgSubscriptionForUpdate mocks base method.
+func (m *MockModelInterface) GetOrgSubscriptionForUpdate(ctx context.Context, orgID uuid.UUID) (*querier.OrgSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgSubscriptionForUpdate", ctx, orgID)
+	ret0, _ := ret[0].(*querier.OrgSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgSubscriptionForUpdate indicates an expected call of GetOrgSubscriptionForUpdate.
+func (mr *MockModelInterfaceMockRecorder) GetOrgSubscriptionForUpdate(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgSubscriptionForUpdate", reflect.TypeOf((*MockModelInterface)(nil).GetOrgSubscriptionForUpdate), ctx, orgID)
+}
+
+// GetOrgUsage mocks base method.
+func (m *MockModelInterface) GetOrgUsage(ctx context.Context, arg querier.GetOrgUsageParams) ([]*querier.GetOrgUsageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgUsage", ctx, arg)
+	ret0, _ := ret[0].([]*querier.GetOrgUsageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgUsage indicates an expected call of GetOrgUsage.
+func (mr *MockModelInterfaceMockRecorder) GetOrgUsage(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgUsage", reflect.TypeOf((*MockModelInterface)(nil).GetOrgUsage), ctx, arg)
+}
+
+// GetPhoneCode mocks base method.
+func (m *MockModelInterface) GetPhoneCode(ctx context.Context, arg querier.GetPhoneCodeParams) (*querier.PhoneCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhoneCode", ctx, arg)
+	ret0, _ := ret[0].(*querier.PhoneCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPhoneCode indicates an expected call of GetPhoneCode.
+func (mr *MockModelInterfaceMockRecorder) GetPhoneCode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoneCode", reflect.TypeOf((*MockModelInterface)(nil).GetPhoneCode), ctx, arg)
+}
+
+// GetRechargeOrder mocks base method.
+func (m *MockModelInterface) GetRechargeOrder(ctx context.Context, arg querier.GetRechargeOrderParams) (*querier.RechargeOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRechargeOrder", ctx, arg)
+	ret0, _ := ret[0].(*querier.RechargeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRechargeOrder indicates an expected call of GetRechargeOrder.
+func (mr *MockModelInterfaceMockRecorder) GetRechargeOrder(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRechargeOrder", reflect.TypeOf((*MockModelInterface)(nil).GetRechargeOrder), ctx, arg)
+}
+
+// GetRechargeOrderForUpdate mocks base method.
+func (m *MockModelInterface) GetRechargeOrderForUpdate(ctx context.Context, id uuid.UUID) (*querier.RechargeOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRechargeOrderForUpdate", ctx, id)
+	ret0, _ := ret[0].(*querier.RechargeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRechargeOrderForUpdate indicates an expected call of GetRechargeOrderForUpdate.
+func (mr *MockModelInterfaceMockRecorder) GetRechargeOrderForUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRechargeOrderForUpdate", reflect.TypeOf((*MockModelInterface)(nil).GetRechargeOrderForUpdate), ctx, id)
+}
+
+// GetTeam mocks base method.
+func (m *MockModelInterface) GetTeam(ctx context.Context, arg querier.GetTeamParams) (*querier.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeam", ctx, arg)
+	ret0, _ := ret[0].(*querier.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockModelInterfaceMockRecorder) GetTeam(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockModelInterface)(nil).GetTeam), ctx, arg)
+}
+
+// GetTeamAccessRuleNames mocks base method.
+func (m *MockModelInterface) GetTeamAccessRuleNames(ctx context.Context, teamID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamAccessRuleNames", ctx, teamID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamAccessRuleNames indicates an expected call of GetTeamAccessRuleNames.
+func (mr *MockModelInterfaceMockRecorder) GetTeamAccessRuleNames(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamAccessRuleNames", reflect.TypeOf((*MockModelInterface)(nil).GetTeamAccessRuleNames), ctx, teamID)
+}
+
+// GetTeamMembers mocks base method.
+func (m *MockModelInterface) GetTeamMembers(ctx context.Context, teamID uuid.UUID) ([]*querier.GetTeamMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMembers", ctx, teamID)
+	ret0, _ := ret[0].([]*querier.GetTeamMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamMembers indicates an expected call of GetTeamMembers.
+func (mr *MockModelInterfaceMockRecorder) GetTeamMembers(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMembers", reflect.TypeOf((*MockModelInterface)(nil).GetTeamMembers), ctx, teamID)
+}
+
+// GetUser mocks base method.
+func (m *MockModelInterface) GetUser(ctx context.Context, phone string) (*querier.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, phone)
+	ret0, _ := ret[0].(*querier.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockModelInterfaceMockRecorder) GetUser(ctx, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockModelInterface)(nil).GetUser), ctx, phone)
+}
+
+// GetUserAccessRuleNames mocks base method.
+func (m *MockModelInterface) GetUserAccessRuleNames(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccessRuleNames", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccessRuleNames indicates an expected call of GetUserAccessRuleNames.
+func (mr *MockModelInterfaceMockRecorder) GetUserAccessRuleNames(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccessRuleNames", reflect.TypeOf((*MockModelInterface)(nil).GetUserAccessRuleNames), ctx, userID)
+}
+
+// GetUserAccessRules mocks base method.
+func (m *MockModelInterface) GetUserAccessRules(ctx context.Context, userID uuid.UUID) ([]*querier.GetUserAccessRulesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccessRules", ctx, userID)
+	ret0, _ := ret[0].([]*querier.GetUserAccessRulesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccessRules indicates an expected call of GetUserAccessRules.
+func (mr *MockModelInterfaceMockRecorder) GetUserAccessRules(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccessRules", reflect.TypeOf((*MockModelInterface)(nil).GetUserAccessRules), ctx, userID)
+}
+
+// GetUserByID mocks base method.
+func (m *MockModelInterface) GetUserByID(ctx context.Context, id uuid.UUID) (*querier.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*querier.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockModelInterfaceMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockModelInterface)(nil).GetUserByID), ctx, id)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockModelInterface) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*querier.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, id)
+	ret0, _ := ret[0].(*querier.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockModelInterfaceMockRecorder) GetWebhookDelivery(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockModelInterface)(nil).GetWebhookDelivery), ctx, id)
+}
+
+// GetWebhookEndpoint mocks base method.
+func (m *MockModelInterface) GetWebhookEndpoint(ctx context.Context, arg querier.GetWebhookEndpointParams) (*querier.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEndpoint", ctx, arg)
+	ret0, _ := ret[0].(*querier.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEndpoint indicates an expected call of GetWebhookEndpoint.
+func (mr *MockModelInterfaceMockRecorder) GetWebhookEndpoint(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEndpoint", reflect.TypeOf((*MockModelInterface)(nil).GetWebhookEndpoint), ctx, arg)
+}
+
+// GetWebhookEndpointByID mocks base method.
+func (m *MockModelInterface) GetWebhookEndpointByID(ctx context.Context, id uuid.UUID) (*querier.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEndpointByID", ctx, id)
+	ret0, _ := ret[0].(*querier.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEndpointByID indicates an expected call of GetWebhookEndpointByID.
+func (mr *MockModelInterfaceMockRecorder) GetWebhookEndpointByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEndpointByID", reflect.TypeOf((*MockModelInterface)(nil).GetWebhookEndpointByID), ctx, id)
+}
+
+// InTransaction mocks base method.
+func (m *MockModelInterface) InTransaction() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTransaction")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// InTransaction indicates an expected call of InTransaction.
+func (mr *MockModelInterfaceMockRecorder) InTransaction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTransaction", reflect.TypeOf((*MockModelInterface)(nil).InTransaction))
+}
+
+// IncreaseUsage mocks base method.
+func (m *MockModelInterface) IncreaseUsage(ctx context.Context, arg querier.IncreaseUsageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseUsage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseUsage indicates an expected call of IncreaseUsage.
+func (mr *MockModelInterfaceMockRecorder) IncreaseUsage(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseUsage", reflect.TypeOf((*MockModelInterface)(nil).IncreaseUsage), ctx, arg)
+}
+
+// InitOrgBalance mocks base method.
+func (m *MockModelInterface) InitOrgBalance(ctx context.Context, orgID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitOrgBalance", ctx, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitOrgBalance indicates an expected call of InitOrgBalance.
+func (mr *MockModelInterfaceMockRecorder) InitOrgBalance(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitOrgBalance", reflect.TypeOf((*MockModelInterface)(nil).InitOrgBalance), ctx, orgID)
+}
+
+// InitUsage mocks base method.
+func (m *MockModelInterface) InitUsage(ctx context.Context, arg querier.InitUsageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitUsage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitUsage indicates an expected call of InitUsage.
+func (mr *MockModelInterfaceMockRecorder) InitUsage(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUsage", reflect.TypeOf((*MockModelInterface)(nil).InitUsage), ctx, arg)
+}
+
+// InsertJob mocks base method.
+func (m *MockModelInterface) InsertJob(ctx context.Context, arg querier.InsertJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertJob", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertJob indicates an expected call of InsertJob.
+func (mr *MockModelInterfaceMockRecorder) InsertJob(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertJob", reflect.TypeOf((*MockModelInterface)(nil).InsertJob), ctx, arg)
+}
+
+// IsPhoneExist mocks base method.
+func (m *MockModelInterface) IsPhoneExist(ctx context.Context, arg querier.IsPhoneExistParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPhoneExist", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPhoneExist indicates an expected call of IsPhoneExist.
+func (mr *MockModelInterfaceMockRecorder) IsPhoneExist(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPhoneExist", reflect.TypeOf((*MockModelInterface)(nil).IsPhoneExist), ctx, arg)
+}
+
+// IsScheduledTaskRun mocks base method.
+func (m *MockModelInterface) IsScheduledTaskRun(ctx context.Context, arg querier.IsScheduledTaskRunParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsScheduledTaskRun", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsScheduledTaskRun indicates an expected call of IsScheduledTaskRun.
+func (mr *MockModelInterfaceMockRecorder) IsScheduledTaskRun(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsScheduledTaskRun", reflect.TypeOf((*MockModelInterface)(nil).IsScheduledTaskRun), ctx, arg)
+}
+
+// IsTeamNameExist mocks base method.
+func (m *MockModelInterface) IsTeamNameExist(ctx context.Context, arg querier.IsTeamNameExistParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTeamNameExist", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTeamNameExist indicates an expected call of IsTeamNameExist.
+func (mr *MockModelInterfaceMockRecorder) IsTeamNameExist(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTeamNameExist", reflect.TypeOf((*MockModelInterface)(nil).IsTeamNameExist), ctx, arg)
+}
+
+// IsUserActive mocks base method.
+func (m *MockModelInterface) IsUserActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserActive", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserActive indicates an expected call of IsUserActive.
+func (mr *MockModelInterfaceMockRecorder) IsUserActive(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserActive", reflect.TypeOf((*MockModelInterface)(nil).IsUserActive), ctx, id)
+}
+
+// IsUsernameExist mocks base method.
+func (m *MockModelInterface) IsUsernameExist(ctx context.Context, arg querier.IsUsernameExistParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUsernameExist", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUsernameExist indicates an expected call of IsUsernameExist.
+func (mr *MockModelInterfaceMockRecorder) IsUsernameExist(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameExist", reflect.TypeOf((*MockModelInterface)(nil).IsUsernameExist), ctx, arg)
+}
+
+// ListOrgMembers mocks base method.
+func (m *MockModelInterface) ListOrgMembers(ctx context.Context, arg querier.ListOrgMembersParams) ([]*querier.ListOrgMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgMembers", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ListOrgMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers.
+func (mr *MockModelInterfaceMockRecorder) ListOrgMembers(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockModelInterface)(nil).ListOrgMembers), ctx, arg)
+}
+
+// ListOrgStatements mocks base method.
+func (m *MockModelInterface) ListOrgStatements(ctx context.Context, orgID uuid.UUID) ([]*querier.OrgStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgStatements", ctx, orgID)
+	ret0, _ := ret[0].([]*querier.OrgStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgStatements indicates an expected call of ListOrgStatements.
+func (mr *MockModelInterfaceMockRecorder) ListOrgStatements(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgStatements", reflect.TypeOf((*MockModelInterface)(nil).ListOrgStatements), ctx, orgID)
+}
+
+// ListOrgTeams mocks base method.
+func (m *MockModelInterface) ListOrgTeams(ctx context.Context, orgID uuid.UUID) ([]*querier.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgTeams", ctx, orgID)
+	ret0, _ := ret[0].([]*querier.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgTeams indicates an expected call of ListOrgTeams.
+func (mr *MockModelInterfaceMockRecorder) ListOrgTeams(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgTeams", reflect.TypeOf((*MockModelInterface)(nil).ListOrgTeams), ctx, orgID)
+}
+
+// ListOutboxDeliveries mocks base method.
+func (m *MockModelInterface) ListOutboxDeliveries(ctx context.Context, eventID int64) ([]*querier.OutboxDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutboxDeliveries", ctx, eventID)
+	ret0, _ := ret[0].([]*querier.OutboxDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutboxDeliveries indicates an expected call of ListOutboxDeliveries.
+func (mr *MockModelInterfaceMockRecorder) ListOutboxDeliveries(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboxDeliveries", reflect.TypeOf((*MockModelInterface)(nil).ListOutboxDeliveries), ctx, eventID)
+}
+
+// ListScheduledTaskRuns mocks base method.
+func (m *MockModelInterface) ListScheduledTaskRuns(ctx context.Context, arg querier.ListScheduledTaskRunsParams) ([]*querier.ScheduledTaskRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTaskRuns", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ScheduledTaskRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTaskRuns indicates an expected call of ListScheduledTaskRuns.
+func (mr *MockModelInterfaceMockRecorder) ListScheduledTaskRuns(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTaskRuns", reflect.TypeOf((*MockModelInterface)(nil).ListScheduledTaskRuns), ctx, arg)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockModelInterface) ListWebhookDeliveries(ctx context.Context, arg querier.ListWebhookDeliveriesParams) ([]*querier.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]*querier.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockModelInterfaceMockRecorder) ListWebhookDeliveries(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockModelInterface)(nil).ListWebhookDeliveries), ctx, arg)
 }
 
-// CreateOrg mocks base method.
-func (m *MockModelInterface) CreateOrg(ctx context.Context, name string) (*querier.Org, error) {
+// ListWebhookEndpoints mocks base method.
+func (m *MockModelInterface) ListWebhookEndpoints(ctx context.Context, orgID uuid.UUID) ([]*querier.WebhookEndpoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrg", ctx, name)
-	ret0, _ := ret[0].(*querier.Org)
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", ctx, orgID)
+	ret0, _ := ret[0].([]*querier.WebhookEndpoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrg indicates an expected call of CreateOrg.
-func (mr *MockModelInterfaceMockRecorder) CreateOrg(ctx, name interface{}) *gomock.Call {
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockModelInterfaceMockRecorder) ListWebhookEndpoints(ctx, orgID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockModelInterface)(nil).CreateOrg), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockModelInterface)(nil).ListWebhookEndpoints), ctx, orgID)
 }
 
-// CreateUser mocks base method.
-func (m *MockModelInterface) CreateUser(ctx context.Context, arg querier.CreateUserParams) (*querier.User, error) {
+// ListWebhookEndpointsByEvent mocks base method.
+func (m *MockModelInterface) ListWebhookEndpointsByEvent(ctx context.Context, arg querier.ListWebhookEndpointsByEventParams) ([]*querier.WebhookEndpoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
-	ret0, _ := ret[0].(*querier.User)
+	ret := m.ctrl.Call(m, "ListWebhookEndpointsByEvent", ctx, arg)
+	ret0, _ := ret[0].([]*querier.WebhookEndpoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockModelInterfaceMockRecorder) CreateUser(ctx, arg interface{}) *gomock.Call {
+// ListWebhookEndpointsByEvent indicates an expected call of ListWebhookEndpointsByEvent.
+func (mr *MockModelInterfaceMockRecorder) ListWebhookEndpointsByEvent(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockModelInterface)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpointsByEvent", reflect.TypeOf((*MockModelInterface)(nil).ListWebhookEndpointsByEvent), ctx, arg)
 }
 
-// GetAccessRule mocks base method.
-func (m *MockModelInterface) GetAccessRule(ctx context.Context, name string) (*querier.AccessRule, error) {
+// Listen mocks base method.
+func (m *MockModelInterface) Listen(ctx context.Context, channel string) (Listener, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccessRule", ctx, name)
-	ret0, _ := ret[0].(*querier.AccessRule)
+	ret := m.ctrl.Call(m, "Listen", ctx, channel)
+	ret0, _ := ret[0].(Listener)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccessRule indicates an expected call of GetAccessRule.
-func (mr *MockModelInterfaceMockRecorder) GetAccessRule(ctx, name interface{}) *gomock.Call {
+// Listen indicates an expected call of Listen.
+func (mr *MockModelInterfaceMockRecorder) Listen(ctx, channel interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRule", reflect.TypeOf((*MockModelInterface)(nil).GetAccessRule), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockModelInterface)(nil).Listen), ctx, channel)
 }
 
-// GetOrgInfoByOrgId mocks base method.
-func (m *MockModelInterface) GetOrgInfoByOrgId(ctx context.Context, id uuid.UUID) (*querier.Org, error) {
+// MarkOutboxEventDead mocks base method.
+func (m *MockModelInterface) MarkOutboxEventDead(ctx context.Context, arg querier.MarkOutboxEventDeadParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrgInfoByOrgId", ctx, id)
-	ret0, _ := ret[0].(*querier.Org)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MarkOutboxEventDead", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrgInfoByOrgId indicates an expected call of GetOrgInfoByOrgId.
-func (mr *MockModelInterfaceMockRecorder) GetOrgInfoByOrgId(ctx, id interface{}) *gomock.Call {
+// MarkOutboxEventDead indicates an expected call of MarkOutboxEventDead.
+func (mr *MockModelInterfaceMockRecorder) MarkOutboxEventDead(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgInfoByOrgId", reflect.TypeOf((*MockModelInterface)(nil).GetOrgInfoByOrgId), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventDead", reflect.TypeOf((*MockModelInterface)(nil).MarkOutboxEventDead), ctx, arg)
 }
 
-// GetPhoneCode mocks base method.
-func (m *MockModelInterface) GetPhoneCode(ctx context.Context, arg querier.GetPhoneCodeParams) (*querier.PhoneCode, error) {
+// MarkOutboxEventDispatched mocks base method.
+func (m *MockModelInterface) MarkOutboxEventDispatched(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPhoneCode", ctx, arg)
-	ret0, _ := ret[0].(*querier.PhoneCode)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MarkOutboxEventDispatched", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPhoneCode indicates an expected call of GetPhoneCode.
-func (mr *MockModelInterfaceMockRecorder) GetPhoneCode(ctx, arg interface{}) *gomock.Call {
+// MarkOutboxEventDispatched indicates an expected call of MarkOutboxEventDispatched.
+func (mr *MockModelInterfaceMockRecorder) MarkOutboxEventDispatched(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoneCode", reflect.TypeOf((*MockModelInterface)(nil).GetPhoneCode), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventDispatched", reflect.TypeOf((*MockModelInterface)(nil).MarkOutboxEventDispatched), ctx, id)
 }
 
-// GetUser mocks base method.
-func (m *MockModelInterface) GetUser(ctx context.Context, phone string) (*querier.User, error) {
+// MarkOutboxEventFailed mocks base method.
+func (m *MockModelInterface) MarkOutboxEventFailed(ctx context.Context, arg querier.MarkOutboxEventFailedParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, phone)
-	ret0, _ := ret[0].(*querier.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MarkOutboxEventFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockModelInterfaceMockRecorder) GetUser(ctx, phone interface{}) *gomock.Call {
+// MarkOutboxEventFailed indicates an expected call of MarkOutboxEventFailed.
+func (mr *MockModelInterfaceMockRecorder) MarkOutboxEventFailed(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockModelInterface)(nil).GetUser), ctx, phone)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventFailed", reflect.TypeOf((*MockModelInterface)(nil).MarkOutboxEventFailed), ctx, arg)
 }
 
-// GetUserAccessRuleNames mocks base method.
-func (m *MockModelInterface) GetUserAccessRuleNames(ctx context.Context, userID uuid.UUID) ([]string, error) {
+// MarkPhoneCodeUsed mocks base method.
+func (m *MockModelInterface) MarkPhoneCodeUsed(ctx context.Context, arg querier.MarkPhoneCodeUsedParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAccessRuleNames", ctx, userID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "MarkPhoneCodeUsed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPhoneCodeUsed indicates an expected call of MarkPhoneCodeUsed.
+func (mr *MockModelInterfaceMockRecorder) MarkPhoneCodeUsed(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPhoneCodeUsed", reflect.TypeOf((*MockModelInterface)(nil).MarkPhoneCodeUsed), ctx, arg)
+}
+
+// MarkRechargeOrderPaid mocks base method.
+func (m *MockModelInterface) MarkRechargeOrderPaid(ctx context.Context, arg querier.MarkRechargeOrderPaidParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRechargeOrderPaid", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRechargeOrderPaid indicates an expected call of MarkRechargeOrderPaid.
+func (mr *MockModelInterfaceMockRecorder) MarkRechargeOrderPaid(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRechargeOrderPaid", reflect.TypeOf((*MockModelInterface)(nil).MarkRechargeOrderPaid), ctx, arg)
+}
+
+// NextInvoiceNo mocks base method.
+func (m *MockModelInterface) NextInvoiceNo(ctx context.Context, year int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextInvoiceNo", ctx, year)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserAccessRuleNames indicates an expected call of GetUserAccessRuleNames.
-func (mr *MockModelInterfaceMockRecorder) GetUserAccessRuleNames(ctx, userID interface{}) *gomock.Call {
+// NextInvoiceNo indicates an expected call of NextInvoiceNo.
+func (mr *MockModelInterfaceMockRecorder) NextInvoiceNo(ctx, year interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccessRuleNames", reflect.TypeOf((*MockModelInterface)(nil).GetUserAccessRuleNames), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextInvoiceNo", reflect.TypeOf((*MockModelInterface)(nil).NextInvoiceNo), ctx, year)
 }
 
-// GetUserAccessRules mocks base method.
-func (m *MockModelInterface) GetUserAccessRules(ctx context.Context, userID uuid.UUID) ([]*querier.GetUserAccessRulesRow, error) {
+// Ping mocks base method.
+func (m *MockModelInterface) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAccessRules", ctx, userID)
-	ret0, _ := ret[0].([]*querier.GetUserAccessRulesRow)
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockModelInterfaceMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockModelInterface)(nil).Ping), ctx)
+}
+
+// PurgeDeletedOrgs mocks base method.
+func (m *MockModelInterface) PurgeDeletedOrgs(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedOrgs", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserAccessRules indicates an expected call of GetUserAccessRules.
-func (mr *MockModelInterfaceMockRecorder) GetUserAccessRules(ctx, userID interface{}) *gomock.Call {
+// PurgeDeletedOrgs indicates an expected call of PurgeDeletedOrgs.
+func (mr *MockModelInterfaceMockRecorder) PurgeDeletedOrgs(ctx, deletedBefore interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccessRules", reflect.TypeOf((*MockModelInterface)(nil).GetUserAccessRules), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedOrgs", reflect.TypeOf((*MockModelInterface)(nil).PurgeDeletedOrgs), ctx, deletedBefore)
 }
 
-// InTransaction mocks base method.
-func (m *MockModelInterface) InTransaction() bool {
+// PurgeDeletedUsers mocks base method.
+func (m *MockModelInterface) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InTransaction")
-	ret0, _ := ret[0].(bool)
-	return ret0
+	ret := m.ctrl.Call(m, "PurgeDeletedUsers", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// InTransaction indicates an expected call of InTransaction.
-func (mr *MockModelInterfaceMockRecorder) InTransaction() *gomock.Call {
+// PurgeDeletedUsers indicates an expected call of PurgeDeletedUsers.
+func (mr *MockModelInterfaceMockRecorder) PurgeDeletedUsers(ctx, deletedBefore interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTransaction", reflect.TypeOf((*MockModelInterface)(nil).InTransaction))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedUsers", reflect.TypeOf((*MockModelInterface)(nil).PurgeDeletedUsers), ctx, deletedBefore)
 }
 
-// IsPhoneExist mocks base method.
-func (m *MockModelInterface) IsPhoneExist(ctx context.Context, phone string) (bool, error) {
+// PurgeExpiredPhoneCodes mocks base method.
+func (m *MockModelInterface) PurgeExpiredPhoneCodes(ctx context.Context, expiredBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsPhoneExist", ctx, phone)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "PurgeExpiredPhoneCodes", ctx, expiredBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsPhoneExist indicates an expected call of IsPhoneExist.
-func (mr *MockModelInterfaceMockRecorder) IsPhoneExist(ctx, phone interface{}) *gomock.Call {
+// PurgeExpiredPhoneCodes indicates an expected call of PurgeExpiredPhoneCodes.
+func (mr *MockModelInterfaceMockRecorder) PurgeExpiredPhoneCodes(ctx, expiredBefore interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPhoneExist", reflect.TypeOf((*MockModelInterface)(nil).IsPhoneExist), ctx, phone)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredPhoneCodes", reflect.TypeOf((*MockModelInterface)(nil).PurgeExpiredPhoneCodes), ctx, expiredBefore)
 }
 
-// IsUsernameExist mocks base method.
-func (m *MockModelInterface) IsUsernameExist(ctx context.Context, name string) (bool, error) {
+// PurgeScheduledTaskRuns mocks base method.
+func (m *MockModelInterface) PurgeScheduledTaskRuns(ctx context.Context, arg querier.PurgeScheduledTaskRunsParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUsernameExist", ctx, name)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "PurgeScheduledTaskRuns", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsUsernameExist indicates an expected call of IsUsernameExist.
-func (mr *MockModelInterfaceMockRecorder) IsUsernameExist(ctx, name interface{}) *gomock.Call {
+// PurgeScheduledTaskRuns indicates an expected call of PurgeScheduledTaskRuns.
+func (mr *MockModelInterfaceMockRecorder) PurgeScheduledTaskRuns(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameExist", reflect.TypeOf((*MockModelInterface)(nil).IsUsernameExist), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeScheduledTaskRuns", reflect.TypeOf((*MockModelInterface)(nil).PurgeScheduledTaskRuns), ctx, arg)
 }
 
-// MarkPhoneCodeUsed mocks base method.
-func (m *MockModelInterface) MarkPhoneCodeUsed(ctx context.Context, arg querier.MarkPhoneCodeUsedParams) error {
+// RemoveRoleAccessRulesExcept mocks base method.
+func (m *MockModelInterface) RemoveRoleAccessRulesExcept(ctx context.Context, arg querier.RemoveRoleAccessRulesExceptParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkPhoneCodeUsed", ctx, arg)
+	ret := m.ctrl.Call(m, "RemoveRoleAccessRulesExcept", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkPhoneCodeUsed indicates an expected call of MarkPhoneCodeUsed.
-func (mr *MockModelInterfaceMockRecorder) MarkPhoneCodeUsed(ctx, arg interface{}) *gomock.Call {
+// RemoveRoleAccessRulesExcept indicates an expected call of RemoveRoleAccessRulesExcept.
+func (mr *MockModelInterfaceMockRecorder) RemoveRoleAccessRulesExcept(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPhoneCodeUsed", reflect.TypeOf((*MockModelInterface)(nil).MarkPhoneCodeUsed), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleAccessRulesExcept", reflect.TypeOf((*MockModelInterface)(nil).RemoveRoleAccessRulesExcept), ctx, arg)
+}
+
+// RemoveTeamAccessRule mocks base method.
+func (m *MockModelInterface) RemoveTeamAccessRule(ctx context.Context, arg querier.RemoveTeamAccessRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamAccessRule", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTeamAccessRule indicates an expected call of RemoveTeamAccessRule.
+func (mr *MockModelInterfaceMockRecorder) RemoveTeamAccessRule(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamAccessRule", reflect.TypeOf((*MockModelInterface)(nil).RemoveTeamAccessRule), ctx, arg)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockModelInterface) RemoveTeamMember(ctx context.Context, arg querier.RemoveTeamMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockModelInterfaceMockRecorder) RemoveTeamMember(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockModelInterface)(nil).RemoveTeamMember), ctx, arg)
 }
 
 // RemoveUserAccessRule mocks base method.
@@ -242,18 +1304,140 @@ func (mr *MockModelInterfaceMockRecorder) RemoveUserAccessRule(ctx, arg interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserAccessRule", reflect.TypeOf((*MockModelInterface)(nil).RemoveUserAccessRule), ctx, arg)
 }
 
+// RenewOrgSubscription mocks base method.
+func (m *MockModelInterface) RenewOrgSubscription(ctx context.Context, arg querier.RenewOrgSubscriptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewOrgSubscription", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewOrgSubscription indicates an expected call of RenewOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) RenewOrgSubscription(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).RenewOrgSubscription), ctx, arg)
+}
+
+// RescueStuckJobs mocks base method.
+func (m *MockModelInterface) RescueStuckJobs(ctx context.Context, lockedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescueStuckJobs", ctx, lockedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescueStuckJobs indicates an expected call of RescueStuckJobs.
+func (mr *MockModelInterfaceMockRecorder) RescueStuckJobs(ctx, lockedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescueStuckJobs", reflect.TypeOf((*MockModelInterface)(nil).RescueStuckJobs), ctx, lockedBefore)
+}
+
+// ResumeOrgSubscription mocks base method.
+func (m *MockModelInterface) ResumeOrgSubscription(ctx context.Context, orgID uuid.UUID) (*querier.OrgSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeOrgSubscription", ctx, orgID)
+	ret0, _ := ret[0].(*querier.OrgSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeOrgSubscription indicates an expected call of ResumeOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) ResumeOrgSubscription(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).ResumeOrgSubscription), ctx, orgID)
+}
+
+// RetryJob mocks base method.
+func (m *MockModelInterface) RetryJob(ctx context.Context, arg querier.RetryJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockModelInterfaceMockRecorder) RetryJob(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockModelInterface)(nil).RetryJob), ctx, arg)
+}
+
 // RunTransaction mocks base method.
-func (m *MockModelInterface) RunTransaction(ctx context.Context, f func(ModelInterface) error) error {
+func (m *MockModelInterface) RunTransaction(ctx context.Context, f func(ModelInterface) error, opts ...TxOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunTransaction", ctx, f)
+	varargs := []interface{}{ctx, f}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunTransaction", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RunTransaction indicates an expected call of RunTransaction.
-func (mr *MockModelInterfaceMockRecorder) RunTransaction(ctx, f interface{}) *gomock.Call {
+func (mr *MockModelInterfaceMockRecorder) RunTransaction(ctx, f interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, f}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTransaction", reflect.TypeOf((*MockModelInterface)(nil).RunTransaction), varargs...)
+}
+
+// SoftDeleteOrg mocks base method.
+func (m *MockModelInterface) SoftDeleteOrg(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteOrg", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteOrg indicates an expected call of SoftDeleteOrg.
+func (mr *MockModelInterfaceMockRecorder) SoftDeleteOrg(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteOrg", reflect.TypeOf((*MockModelInterface)(nil).SoftDeleteOrg), ctx, id)
+}
+
+// SoftDeleteUser mocks base method.
+func (m *MockModelInterface) SoftDeleteUser(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteUser indicates an expected call of SoftDeleteUser.
+func (mr *MockModelInterfaceMockRecorder) SoftDeleteUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUser", reflect.TypeOf((*MockModelInterface)(nil).SoftDeleteUser), ctx, id)
+}
+
+// TryAdvisoryLock mocks base method.
+func (m *MockModelInterface) TryAdvisoryLock(ctx context.Context, key string) (AdvisoryLock, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryAdvisoryLock", ctx, key)
+	ret0, _ := ret[0].(AdvisoryLock)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TryAdvisoryLock indicates an expected call of TryAdvisoryLock.
+func (mr *MockModelInterfaceMockRecorder) TryAdvisoryLock(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTransaction", reflect.TypeOf((*MockModelInterface)(nil).RunTransaction), ctx, f)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryAdvisoryLock", reflect.TypeOf((*MockModelInterface)(nil).TryAdvisoryLock), ctx, key)
+}
+
+// UpdateOrgBalance mocks base method.
+func (m *MockModelInterface) UpdateOrgBalance(ctx context.Context, arg querier.UpdateOrgBalanceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrgBalance", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrgBalance indicates an expected call of UpdateOrgBalance.
+func (mr *MockModelInterfaceMockRecorder) UpdateOrgBalance(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgBalance", reflect.TypeOf((*MockModelInterface)(nil).UpdateOrgBalance), ctx, arg)
 }
 
 // UpdateOrgOwnerID mocks base method.
@@ -270,6 +1454,35 @@ func (mr *MockModelInterfaceMockRecorder) UpdateOrgOwnerID(ctx, arg interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgOwnerID", reflect.TypeOf((*MockModelInterface)(nil).UpdateOrgOwnerID), ctx, arg)
 }
 
+// UpdatePendingRechargeOrderStatus mocks base method.
+func (m *MockModelInterface) UpdatePendingRechargeOrderStatus(ctx context.Context, arg querier.UpdatePendingRechargeOrderStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingRechargeOrderStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePendingRechargeOrderStatus indicates an expected call of UpdatePendingRechargeOrderStatus.
+func (mr *MockModelInterfaceMockRecorder) UpdatePendingRechargeOrderStatus(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingRechargeOrderStatus", reflect.TypeOf((*MockModelInterface)(nil).UpdatePendingRechargeOrderStatus), ctx, arg)
+}
+
+// UpdateTeamName mocks base method.
+func (m *MockModelInterface) UpdateTeamName(ctx context.Context, arg querier.UpdateTeamNameParams) (*querier.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamName", ctx, arg)
+	ret0, _ := ret[0].(*querier.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamName indicates an expected call of UpdateTeamName.
+func (mr *MockModelInterfaceMockRecorder) UpdateTeamName(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamName", reflect.TypeOf((*MockModelInterface)(nil).UpdateTeamName), ctx, arg)
+}
+
 // UpdateUserPasswordByPhone mocks base method.
 func (m *MockModelInterface) UpdateUserPasswordByPhone(ctx context.Context, arg querier.UpdateUserPasswordByPhoneParams) error {
 	m.ctrl.T.Helper()
@@ -284,6 +1497,65 @@ func (mr *MockModelInterfaceMockRecorder) UpdateUserPasswordByPhone(ctx, arg int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordByPhone", reflect.TypeOf((*MockModelInterface)(nil).UpdateUserPasswordByPhone), ctx, arg)
 }
 
+// UpdateWebhookDeliveryResult mocks base method.
+func (m *MockModelInterface) UpdateWebhookDeliveryResult(ctx context.Context, arg querier.UpdateWebhookDeliveryResultParams) (*querier.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDeliveryResult", ctx, arg)
+	ret0, _ := ret[0].(*querier.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookDeliveryResult indicates an expected call of UpdateWebhookDeliveryResult.
+func (mr *MockModelInterfaceMockRecorder) UpdateWebhookDeliveryResult(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDeliveryResult", reflect.TypeOf((*MockModelInterface)(nil).UpdateWebhookDeliveryResult), ctx, arg)
+}
+
+// UpdateWebhookEndpoint mocks base method.
+func (m *MockModelInterface) UpdateWebhookEndpoint(ctx context.Context, arg querier.UpdateWebhookEndpointParams) (*querier.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookEndpoint", ctx, arg)
+	ret0, _ := ret[0].(*querier.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookEndpoint indicates an expected call of UpdateWebhookEndpoint.
+func (mr *MockModelInterfaceMockRecorder) UpdateWebhookEndpoint(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookEndpoint", reflect.TypeOf((*MockModelInterface)(nil).UpdateWebhookEndpoint), ctx, arg)
+}
+
+// UpsertOrgSubscription mocks base method.
+func (m *MockModelInterface) UpsertOrgSubscription(ctx context.Context, arg querier.UpsertOrgSubscriptionParams) (*querier.OrgSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOrgSubscription", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOrgSubscription indicates an expected call of UpsertOrgSubscription.
+func (mr *MockModelInterfaceMockRecorder) UpsertOrgSubscription(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOrgSubscription", reflect.TypeOf((*MockModelInterface)(nil).UpsertOrgSubscription), ctx, arg)
+}
+
+// UpsertOutboxDelivery mocks base method.
+func (m *MockModelInterface) UpsertOutboxDelivery(ctx context.Context, arg querier.UpsertOutboxDeliveryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOutboxDelivery", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOutboxDelivery indicates an expected call of UpsertOutboxDelivery.
+func (mr *MockModelInterfaceMockRecorder) UpsertOutboxDelivery(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOutboxDelivery", reflect.TypeOf((*MockModelInterface)(nil).UpsertOutboxDelivery), ctx, arg)
+}
+
 // UpsertPhoneCode mocks base method.
 func (m *MockModelInterface) UpsertPhoneCode(ctx context.Context, arg querier.UpsertPhoneCodeParams) (*querier.PhoneCode, error) {
 	m.ctrl.T.Helper()
@@ -298,3 +1570,18 @@ func (mr *MockModelInterfaceMockRecorder) UpsertPhoneCode(ctx, arg interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPhoneCode", reflect.TypeOf((*MockModelInterface)(nil).UpsertPhoneCode), ctx, arg)
 }
+
+// UpsertRole mocks base method.
+func (m *MockModelInterface) UpsertRole(ctx context.Context, arg querier.UpsertRoleParams) (*querier.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRole", ctx, arg)
+	ret0, _ := ret[0].(*querier.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRole indicates an expected call of UpsertRole.
+func (mr *MockModelInterfaceMockRecorder) UpsertRole(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRole", reflect.TypeOf((*MockModelInterface)(nil).UpsertRole), ctx, arg)
+}
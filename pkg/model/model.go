@@ -2,46 +2,91 @@ package model
 
 import (
 	"context"
-	"fmt"
 	"time"
 
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 	"github.com/xich-dev/go-starter/pkg/config"
 	"github.com/xich-dev/go-starter/pkg/logger"
 	"github.com/xich-dev/go-starter/pkg/model/querier"
-	"github.com/xich-dev/go-starter/pkg/utils"
 )
 
 var log = logger.NewLogAgent("model")
 
-var (
-	ErrAlreadyInTransaction = errors.New("already in transaction")
-)
-
 type ModelInterface interface {
 	querier.Querier
-	RunTransaction(ctx context.Context, f func(model ModelInterface) error) error
+	// RunTransaction runs f in a transaction, f is run again in a new transaction if
+	// the transaction fails on a serialization failure or a deadlock, so f must not
+	// have side effects outside the transaction.
+	// Called on a model already in a transaction, f runs in a savepoint which is rolled
+	// back if f returns an error, leaving the outer transaction usable. Savepoints are
+	// never retried and the options are ignored, since they are decided by the outer
+	// transaction.
+	RunTransaction(ctx context.Context, f func(model ModelInterface) error, opts ...TxOption) error
 	InTransaction() bool
+	// Listen starts listening on the Postgres channel, the notifications are sent when
+	// the transactions calling pg_notify commit.
+	Listen(ctx context.Context, channel string) (Listener, error)
+	// TryAdvisoryLock takes the advisory lock of the key on a dedicated connection
+	// without waiting, false is returned if it is held by another session. The lock
+	// is held until it is unlocked, outside of any transaction.
+	TryAdvisoryLock(ctx context.Context, key string) (AdvisoryLock, bool, error)
+	// Ping checks that the primary is reachable.
+	Ping(ctx context.Context) error
+	GetMigrationStatus(ctx context.Context) (*MigrationStatus, error)
 }
 
 type Model struct {
 	querier.Querier
-	beginTx       func(ctx context.Context) (pgx.Tx, error)
+	beginTx       func(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
 	p             *pgxpool.Pool
+	replicas      *replicaSet
 	inTransaction bool
+	// the version of the last migration available
+	latestMigration uint
+}
+
+func (m *Model) Ping(ctx context.Context) error {
+	return m.p.Ping(ctx)
+}
+
+// Close closes the pools after waiting for the connections in use to be released, it
+// is called once the server and the workers are stopped.
+func (m *Model) Close() {
+	if m.replicas != nil {
+		m.replicas.close()
+	}
+	m.p.Close()
+	log.Info("database connections closed")
 }
 
 func (m *Model) InTransaction() bool {
 	return m.inTransaction
 }
 
-func (m *Model) RunTransaction(ctx context.Context, f func(model ModelInterface) error) error {
-	tx, err := m.beginTx(ctx)
+func (m *Model) RunTransaction(ctx context.Context, f func(model ModelInterface) error, opts ...TxOption) error {
+	o := newTxOptions(opts)
+	if m.inTransaction {
+		return m.runTransaction(ctx, f, o.TxOptions)
+	}
+	for attempt := 0; ; attempt++ {
+		err := m.runTransaction(ctx, f, o.TxOptions)
+		if err == nil || !isRetryable(err) || attempt >= o.maxRetries {
+			return err
+		}
+		delay := retryDelay(attempt)
+		log.Warnf("retrying transaction in %s (%d/%d): %s", delay, attempt+1, o.maxRetries, err.Error())
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), err.Error())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (m *Model) runTransaction(ctx context.Context, f func(model ModelInterface) error, txOptions pgx.TxOptions) error {
+	tx, err := m.beginTx(ctx, txOptions)
 	if err != nil {
 		return err
 	}
@@ -50,10 +95,13 @@ func (m *Model) RunTransaction(ctx context.Context, f func(model ModelInterface)
 	if err := f(
 		&Model{
 			Querier: querier.New(tx),
-			beginTx: func(ctx context.Context) (pgx.Tx, error) {
-				return nil, ErrAlreadyInTransaction
+			// pgx implements nested transactions with savepoints
+			beginTx: func(ctx context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+				return tx.Begin(ctx)
 			},
-			inTransaction: true,
+			p:               m.p,
+			inTransaction:   true,
+			latestMigration: m.latestMigration,
 		},
 	); err != nil {
 		return err
@@ -67,26 +115,25 @@ func (m *Model) dataInit() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	stmt := "INSERT INTO access_rules (name) VALUES "
-	for i, rule := range AllRules {
-		stmt += fmt.Sprintf("('%s')%s", rule, utils.IfElse(i == len(AllRules)-1, "", ","))
+	for _, rule := range AllRules {
+		if err := m.CreateAccessRuleIfNotExists(ctx, rule); err != nil {
+			return errors.Wrapf(err, "failed to create access rule %s", rule)
+		}
 	}
-	stmt += "ON CONFLICT DO NOTHING"
-
-	_, err := m.p.Exec(ctx, stmt)
-	return err
+	return nil
 }
 
-func NewModel(cfg *config.Config) (ModelInterface, error) {
-	url := fmt.Sprintf("%s:%s@%s:%d/%s?connect_timeout=%d&timezone=Asia/Shanghai", cfg.Pg.User, cfg.Pg.Password, cfg.Pg.Host, cfg.Pg.Port, cfg.Pg.Db, 15)
-	dsn := fmt.Sprintf("postgres://%s", url)
-	config, err := pgxpool.ParseConfig(dsn)
+// NewModel connects to the database and runs the migrations, the returned cleanup
+// function closes the connections.
+func NewModel(cfg *config.Config) (ModelInterface, func(), error) {
+	dsn := buildDSN(&cfg.Pg)
+	config, err := newPoolConfig(&cfg.Pg, dsn)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse pgxpool config: %s", dsn)
+		return nil, nil, err
 	}
 
 	var (
-		retryLimit = 10
+		retryLimit = cfg.Pg.ConnectRetries
 		retry      = 0
 	)
 
@@ -100,8 +147,10 @@ func NewModel(cfg *config.Config) (ModelInterface, error) {
 		if err != nil {
 			log.Warnf("failed to init pgxpool: %s", err.Error())
 			if retry >= retryLimit {
-				return nil, errors.Wrapf(err, "failed to init pgxpool: %s", dsn)
+				return nil, nil, errors.Wrapf(err, "failed to init pgxpool: %s", redactDSN(dsn))
 			}
+			retry++
+			time.Sleep(3 * time.Second)
 			continue
 		}
 
@@ -110,7 +159,7 @@ func NewModel(cfg *config.Config) (ModelInterface, error) {
 		if err := pool.Ping(ctx); err != nil {
 			log.Warnf("failed to ping database: %s", err.Error())
 			if retry >= retryLimit {
-				return nil, errors.Wrap(err, "failed to ping db")
+				return nil, nil, errors.Wrap(err, "failed to ping db")
 			}
 		} else {
 			break
@@ -119,21 +168,49 @@ func NewModel(cfg *config.Config) (ModelInterface, error) {
 		time.Sleep(3 * time.Second)
 	}
 
-	m, err := migrate.New(fmt.Sprintf("file://%s", cfg.Pg.Migration), fmt.Sprintf("pgx5://%s", url))
+	if cfg.Pg.SkipMigration {
+		log.Info("skipping migrations")
+	} else {
+		migrator, err := NewMigrator(cfg)
+		if err != nil {
+			p.Close()
+			return nil, nil, err
+		}
+		err = migrator.Up(context.Background())
+		if closeErr := migrator.Close(); closeErr != nil {
+			log.Warnf("failed to close migrator: %s", closeErr.Error())
+		}
+		if err != nil {
+			p.Close()
+			return nil, nil, err
+		}
+	}
+
+	latestMigration, err := latestMigrationVersion(cfg.Pg.Migration)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to init migrate")
+		p.Close()
+		return nil, nil, err
 	}
-	if err := m.Up(); err != nil {
-		if !errors.Is(err, migrate.ErrNoChange) {
-			return nil, errors.Wrap(err, "failed to migrate up")
+
+	model := &Model{beginTx: p.BeginTx, p: p, latestMigration: latestMigration}
+	var db querier.DBTX = p
+	if len(cfg.Pg.Replicas) > 0 {
+		replicas, err := newReplicas(&cfg.Pg)
+		if err != nil {
+			p.Close()
+			return nil, nil, err
 		}
+		model.replicas = newReplicaSet(replicas)
+		db = &routingDB{primary: p, replicas: model.replicas}
+		log.Infof("routing read-only queries to %d replicas", len(replicas))
 	}
+	model.Querier = querier.New(db)
 
-	model := &Model{Querier: querier.New(p), beginTx: p.Begin, p: p}
 	if err := model.dataInit(); err != nil {
-		return nil, errors.Wrap(err, "failed to init data")
+		model.Close()
+		return nil, nil, errors.Wrap(err, "failed to init data")
 	}
 	log.Info("model init success")
 
-	return model, nil
+	return model, model.Close, nil
 }
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+// Listener receives the notifications of a Postgres channel on a dedicated connection.
+type Listener interface {
+	// Wait blocks until a notification is received or ctx is done.
+	Wait(ctx context.Context) error
+	// Close closes the connection, it is taken out of the pool.
+	Close()
+}
+
+type listener struct {
+	conn *pgxpool.Conn
+}
+
+func (l *listener) Wait(ctx context.Context) error {
+	_, err := l.conn.Conn().WaitForNotification(ctx)
+	return err
+}
+
+func (l *listener) Close() {
+	// the connection is still listening, it must not be reused
+	conn := l.conn.Hijack()
+	conn.Close(context.Background())
+}
+
+func (m *Model) Listen(ctx context.Context, channel string) (Listener, error) {
+	if m.inTransaction {
+		return nil, errors.New("cannot listen in a transaction")
+	}
+	conn, err := m.p.Acquire(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to acquire connection")
+	}
+	if _, err := conn.Exec(ctx, fmt.Sprintf("LISTEN %s", pgx.Identifier{channel}.Sanitize())); err != nil {
+		conn.Release()
+		return nil, errors.Wrapf(err, "failed to listen on %s", channel)
+	}
+	return &listener{conn: conn}, nil
+}
@@ -13,7 +13,7 @@ import (
 
 const addUserAccessRule = `-- name: AddUserAccessRule :exec
 INSERT INTO user_access_rules (user_id, rule_id) VALUES ((
-    SELECT id FROM users WHERE name = $1
+    SELECT id FROM users WHERE name = $1 AND deleted_at IS NULL
 ), $2) ON CONFLICT DO NOTHING
 `
 
@@ -27,6 +27,15 @@ func (q *Queries) AddUserAccessRule(ctx context.Context, arg AddUserAccessRulePa
 	return err
 }
 
+const createAccessRuleIfNotExists = `-- name: CreateAccessRuleIfNotExists :exec
+INSERT INTO access_rules (name) VALUES ($1) ON CONFLICT (name) DO NOTHING
+`
+
+func (q *Queries) CreateAccessRuleIfNotExists(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, createAccessRuleIfNotExists, name)
+	return err
+}
+
 const getAccessRule = `-- name: GetAccessRule :one
 SELECT id, name, created_at, updated_at, deleted_at FROM access_rules WHERE name = $1
 `
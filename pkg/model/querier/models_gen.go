@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type AccessRule struct {
@@ -18,6 +19,46 @@ type AccessRule struct {
 	DeletedAt *time.Time
 }
 
+type InvoiceCounter struct {
+	Year      int32
+	LastNo    int64
+	UpdatedAt time.Time
+}
+
+type Job struct {
+	ID          int64
+	Kind        string
+	Args        []byte
+	UniqueKey   *string
+	Status      string
+	Attempts    int32
+	MaxAttempts int32
+	LastError   *string
+	RunAt       time.Time
+	LockedAt    *time.Time
+	FinishedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type LedgerEntry struct {
+	ID            int64
+	TransactionID uuid.UUID
+	Account       string
+	OrgID         uuid.NullUUID
+	Amount        int64
+	CreatedAt     time.Time
+}
+
+type LedgerTransaction struct {
+	ID          uuid.UUID
+	OrgID       uuid.UUID
+	Typ         string
+	Amount      int64
+	Description string
+	CreatedAt   time.Time
+}
+
 type Org struct {
 	ID        uuid.UUID
 	Name      string
@@ -25,6 +66,58 @@ type Org struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+	Version   int32
+}
+
+type OrgBalance struct {
+	OrgID     uuid.UUID
+	Balance   int64
+	UpdatedAt time.Time
+}
+
+type OrgStatement struct {
+	ID             uuid.UUID
+	OrgID          uuid.UUID
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	InvoiceNo      string
+	OpeningBalance int64
+	ClosingBalance int64
+	CreatedAt      time.Time
+}
+
+type OrgSubscription struct {
+	OrgID              uuid.UUID
+	Plan               string
+	CurrentPeriodStart time.Time
+	CurrentPeriodEnd   time.Time
+	CancelAtPeriodEnd  bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Version            int32
+}
+
+type OutboxDelivery struct {
+	EventID     int64
+	Handler     string
+	Attempts    int32
+	LastError   *string
+	DeliveredAt *time.Time
+	DeadAt      *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type OutboxEvent struct {
+	ID           int64
+	Typ          string
+	Payload      []byte
+	Attempts     int32
+	LastError    *string
+	AvailableAt  time.Time
+	DispatchedAt *time.Time
+	DeadAt       *time.Time
+	CreatedAt    time.Time
 }
 
 type PhoneCode struct {
@@ -36,6 +129,73 @@ type PhoneCode struct {
 	ExpiredAt time.Time
 }
 
+type RechargeOrder struct {
+	ID                  uuid.UUID
+	OrgID               uuid.UUID
+	UserID              uuid.NullUUID
+	Provider            string
+	Amount              int64
+	Status              string
+	ProviderTradeID     *string
+	LedgerTransactionID uuid.NullUUID
+	ExpiredAt           time.Time
+	PaidAt              *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type Role struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RoleAccessRule struct {
+	RoleID uuid.UUID
+	RuleID uuid.UUID
+}
+
+type ScheduledTaskRun struct {
+	ID          uuid.UUID
+	Task        string
+	ScheduledAt time.Time
+	Status      string
+	Error       *string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	CreatedAt   time.Time
+}
+
+type Team struct {
+	ID        uuid.UUID
+	OrgID     uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   int32
+}
+
+type TeamAccessRule struct {
+	TeamID uuid.UUID
+	RuleID uuid.UUID
+}
+
+type TeamMember struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+type UsageDaily struct {
+	OrgID     uuid.UUID
+	Metric    string
+	Day       pgtype.Date
+	Count     int64
+	UpdatedAt time.Time
+}
+
 type User struct {
 	ID           uuid.UUID
 	Name         string
@@ -47,9 +207,41 @@ type User struct {
 	DeletedAt    *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Version      int32
 }
 
 type UserAccessRule struct {
 	UserID uuid.UUID
 	RuleID uuid.UUID
 }
+
+type UserRole struct {
+	UserID uuid.UUID
+	RoleID uuid.UUID
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID
+	EndpointID    uuid.UUID
+	Event         string
+	Payload       []byte
+	OutboxEventID *int64
+	Status        string
+	Attempts      int32
+	ResponseCode  *int32
+	LastError     *string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type WebhookEndpoint struct {
+	ID        uuid.UUID
+	OrgID     uuid.UUID
+	Url       string
+	Secret    string
+	Events    []string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -7,6 +7,7 @@ package querier
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,7 +15,7 @@ import (
 const createOrg = `-- name: CreateOrg :one
 INSERT INTO orgs (
     name
-) VALUES ($1) RETURNING id, name, owner_id, created_at, updated_at, deleted_at
+) VALUES ($1) RETURNING id, name, owner_id, created_at, updated_at, deleted_at, version
 `
 
 func (q *Queries) CreateOrg(ctx context.Context, name string) (*Org, error) {
@@ -27,10 +28,37 @@ func (q *Queries) CreateOrg(ctx context.Context, name string) (*Org, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Version,
 	)
 	return &i, err
 }
 
+const purgeDeletedOrgs = `-- name: PurgeDeletedOrgs :execrows
+DELETE FROM orgs
+WHERE deleted_at < $1::TIMESTAMPTZ
+    AND NOT EXISTS (SELECT 1 FROM users WHERE users.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM ledger_transactions WHERE ledger_transactions.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM recharge_orders WHERE recharge_orders.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM org_statements WHERE org_statements.org_id = orgs.id)
+`
+
+func (q *Queries) PurgeDeletedOrgs(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedOrgs, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteOrg = `-- name: SoftDeleteOrg :exec
+UPDATE orgs SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteOrg(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, softDeleteOrg, id)
+	return err
+}
+
 const updateOrgOwnerID = `-- name: UpdateOrgOwnerID :exec
 UPDATE orgs SET owner_id = $1 WHERE id = $2
 `
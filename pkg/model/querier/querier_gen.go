@@ -6,27 +6,111 @@ package querier
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddRoleAccessRules(ctx context.Context, arg AddRoleAccessRulesParams) error
+	AddTeamAccessRule(ctx context.Context, arg AddTeamAccessRuleParams) error
+	AddTeamMember(ctx context.Context, arg AddTeamMemberParams) error
 	AddUserAccessRule(ctx context.Context, arg AddUserAccessRuleParams) error
+	AddUserRole(ctx context.Context, arg AddUserRoleParams) error
+	CancelOrgSubscription(ctx context.Context, arg CancelOrgSubscriptionParams) (int64, error)
+	ClaimNextJob(ctx context.Context, now time.Time) (*Job, error)
+	ClaimNextOutboxEvent(ctx context.Context, arg ClaimNextOutboxEventParams) (*OutboxEvent, error)
+	CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error)
+	ConsumeUsage(ctx context.Context, arg ConsumeUsageParams) (int64, error)
+	CountOtherOrgMembers(ctx context.Context, arg CountOtherOrgMembersParams) (int64, error)
+	CreateAccessRuleIfNotExists(ctx context.Context, name string) error
+	CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) error
+	CreateLedgerTransaction(ctx context.Context, arg CreateLedgerTransactionParams) (*LedgerTransaction, error)
 	CreateOrg(ctx context.Context, name string) (*Org, error)
+	CreateOrgStatement(ctx context.Context, arg CreateOrgStatementParams) (*OrgStatement, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	CreateRechargeOrder(ctx context.Context, arg CreateRechargeOrderParams) (*RechargeOrder, error)
+	CreateScheduledTaskRun(ctx context.Context, arg CreateScheduledTaskRunParams) (*ScheduledTaskRun, error)
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (*Team, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (*User, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (*WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (*WebhookEndpoint, error)
+	DeleteOrgSubscription(ctx context.Context, orgID uuid.UUID) error
+	DeleteTeam(ctx context.Context, arg DeleteTeamParams) (int64, error)
+	DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error)
+	FailJob(ctx context.Context, arg FailJobParams) (int64, error)
 	GetAccessRule(ctx context.Context, name string) (*AccessRule, error)
+	GetDueOrgSubscriptionForUpdate(ctx context.Context, arg GetDueOrgSubscriptionForUpdateParams) (*OrgSubscription, error)
+	GetOrgBalance(ctx context.Context, orgID uuid.UUID) (int64, error)
+	GetOrgBalanceForUpdate(ctx context.Context, orgID uuid.UUID) (int64, error)
 	GetOrgInfoByOrgId(ctx context.Context, id uuid.UUID) (*Org, error)
+	GetOrgLedgerBalanceBefore(ctx context.Context, arg GetOrgLedgerBalanceBeforeParams) (int64, error)
+	GetOrgLedgerSummary(ctx context.Context, arg GetOrgLedgerSummaryParams) ([]*GetOrgLedgerSummaryRow, error)
+	GetOrgStatement(ctx context.Context, arg GetOrgStatementParams) (*OrgStatement, error)
+	GetOrgSubscription(ctx context.Context, orgID uuid.UUID) (*OrgSubscription, error)
+	GetOrgSubscriptionForUpdate(ctx context.Context, orgID uuid.UUID) (*OrgSubscription, error)
+	GetOrgUsage(ctx context.Context, arg GetOrgUsageParams) ([]*GetOrgUsageRow, error)
 	GetPhoneCode(ctx context.Context, arg GetPhoneCodeParams) (*PhoneCode, error)
+	GetRechargeOrder(ctx context.Context, arg GetRechargeOrderParams) (*RechargeOrder, error)
+	GetRechargeOrderForUpdate(ctx context.Context, id uuid.UUID) (*RechargeOrder, error)
+	GetTeam(ctx context.Context, arg GetTeamParams) (*Team, error)
+	GetTeamAccessRuleNames(ctx context.Context, teamID uuid.UUID) ([]string, error)
+	GetTeamMembers(ctx context.Context, teamID uuid.UUID) ([]*GetTeamMembersRow, error)
 	GetUser(ctx context.Context, phone string) (*User, error)
 	GetUserAccessRuleNames(ctx context.Context, userID uuid.UUID) ([]string, error)
 	GetUserAccessRules(ctx context.Context, userID uuid.UUID) ([]*GetUserAccessRulesRow, error)
-	IsPhoneExist(ctx context.Context, phone string) (bool, error)
-	IsUsernameExist(ctx context.Context, name string) (bool, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (*WebhookEndpoint, error)
+	GetWebhookEndpointByID(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error)
+	IncreaseUsage(ctx context.Context, arg IncreaseUsageParams) error
+	InitOrgBalance(ctx context.Context, orgID uuid.UUID) error
+	InitUsage(ctx context.Context, arg InitUsageParams) error
+	InsertJob(ctx context.Context, arg InsertJobParams) (int64, error)
+	IsPhoneExist(ctx context.Context, arg IsPhoneExistParams) (bool, error)
+	IsScheduledTaskRun(ctx context.Context, arg IsScheduledTaskRunParams) (bool, error)
+	IsTeamNameExist(ctx context.Context, arg IsTeamNameExistParams) (bool, error)
+	IsUserActive(ctx context.Context, id uuid.UUID) (bool, error)
+	IsUsernameExist(ctx context.Context, arg IsUsernameExistParams) (bool, error)
+	ListOrgMembers(ctx context.Context, arg ListOrgMembersParams) ([]*ListOrgMembersRow, error)
+	ListOrgStatements(ctx context.Context, orgID uuid.UUID) ([]*OrgStatement, error)
+	ListOrgTeams(ctx context.Context, orgID uuid.UUID) ([]*Team, error)
+	ListOutboxDeliveries(ctx context.Context, eventID int64) ([]*OutboxDelivery, error)
+	ListScheduledTaskRuns(ctx context.Context, arg ListScheduledTaskRunsParams) ([]*ScheduledTaskRun, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]*WebhookDelivery, error)
+	ListWebhookEndpoints(ctx context.Context, orgID uuid.UUID) ([]*WebhookEndpoint, error)
+	ListWebhookEndpointsByEvent(ctx context.Context, arg ListWebhookEndpointsByEventParams) ([]*WebhookEndpoint, error)
+	MarkOutboxEventDead(ctx context.Context, arg MarkOutboxEventDeadParams) error
+	MarkOutboxEventDispatched(ctx context.Context, id int64) error
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
 	MarkPhoneCodeUsed(ctx context.Context, arg MarkPhoneCodeUsedParams) error
+	MarkRechargeOrderPaid(ctx context.Context, arg MarkRechargeOrderPaidParams) error
+	NextInvoiceNo(ctx context.Context, year int32) (int64, error)
+	PurgeDeletedOrgs(ctx context.Context, deletedBefore time.Time) (int64, error)
+	PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (int64, error)
+	PurgeExpiredPhoneCodes(ctx context.Context, expiredBefore time.Time) (int64, error)
+	PurgeScheduledTaskRuns(ctx context.Context, arg PurgeScheduledTaskRunsParams) (int64, error)
+	RemoveRoleAccessRulesExcept(ctx context.Context, arg RemoveRoleAccessRulesExceptParams) error
+	RemoveTeamAccessRule(ctx context.Context, arg RemoveTeamAccessRuleParams) error
+	RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) error
 	RemoveUserAccessRule(ctx context.Context, arg RemoveUserAccessRuleParams) error
+	RenewOrgSubscription(ctx context.Context, arg RenewOrgSubscriptionParams) error
+	RescueStuckJobs(ctx context.Context, lockedBefore time.Time) (int64, error)
+	ResumeOrgSubscription(ctx context.Context, orgID uuid.UUID) (*OrgSubscription, error)
+	RetryJob(ctx context.Context, arg RetryJobParams) (int64, error)
+	SoftDeleteOrg(ctx context.Context, id uuid.UUID) error
+	SoftDeleteUser(ctx context.Context, id uuid.UUID) error
+	UpdateOrgBalance(ctx context.Context, arg UpdateOrgBalanceParams) error
 	UpdateOrgOwnerID(ctx context.Context, arg UpdateOrgOwnerIDParams) error
+	UpdatePendingRechargeOrderStatus(ctx context.Context, arg UpdatePendingRechargeOrderStatusParams) error
+	UpdateTeamName(ctx context.Context, arg UpdateTeamNameParams) (*Team, error)
 	UpdateUserPasswordByPhone(ctx context.Context, arg UpdateUserPasswordByPhoneParams) error
+	UpdateWebhookDeliveryResult(ctx context.Context, arg UpdateWebhookDeliveryResultParams) (*WebhookDelivery, error)
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (*WebhookEndpoint, error)
+	UpsertOrgSubscription(ctx context.Context, arg UpsertOrgSubscriptionParams) (*OrgSubscription, error)
+	UpsertOutboxDelivery(ctx context.Context, arg UpsertOutboxDeliveryParams) error
 	UpsertPhoneCode(ctx context.Context, arg UpsertPhoneCodeParams) (*PhoneCode, error)
+	UpsertRole(ctx context.Context, arg UpsertRoleParams) (*Role, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -12,6 +12,22 @@ import (
 	"github.com/google/uuid"
 )
 
+const countOtherOrgMembers = `-- name: CountOtherOrgMembers :one
+SELECT COUNT(*) FROM users WHERE org_id = $1 AND id <> $2 AND deleted_at IS NULL
+`
+
+type CountOtherOrgMembersParams struct {
+	OrgID uuid.UUID
+	ID    uuid.UUID
+}
+
+func (q *Queries) CountOtherOrgMembers(ctx context.Context, arg CountOtherOrgMembersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOtherOrgMembers, arg.OrgID, arg.ID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     org_id,
@@ -19,7 +35,7 @@ INSERT INTO users (
     phone,
     password_hash,
     password_salt
-) VALUES ($1, $2, $3, $4, $5) RETURNING id, name, phone, password_hash, password_salt, refresh_token, org_id, deleted_at, created_at, updated_at
+) VALUES ($1, $2, $3, $4, $5) RETURNING id, name, phone, password_hash, password_salt, refresh_token, org_id, deleted_at, created_at, updated_at, version
 `
 
 type CreateUserParams struct {
@@ -50,12 +66,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (*User,
 		&i.DeletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
 	)
 	return &i, err
 }
 
 const getOrgInfoByOrgId = `-- name: GetOrgInfoByOrgId :one
-SELECT id, name, owner_id, created_at, updated_at, deleted_at FROM orgs WHERE id = $1
+SELECT id, name, owner_id, created_at, updated_at, deleted_at, version FROM orgs WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetOrgInfoByOrgId(ctx context.Context, id uuid.UUID) (*Org, error) {
@@ -68,6 +85,7 @@ func (q *Queries) GetOrgInfoByOrgId(ctx context.Context, id uuid.UUID) (*Org, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Version,
 	)
 	return &i, err
 }
@@ -96,7 +114,7 @@ func (q *Queries) GetPhoneCode(ctx context.Context, arg GetPhoneCodeParams) (*Ph
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, name, phone, password_hash, password_salt, refresh_token, org_id, deleted_at, created_at, updated_at FROM users WHERE phone = $1 OR name = $1
+SELECT id, name, phone, password_hash, password_salt, refresh_token, org_id, deleted_at, created_at, updated_at, version FROM users WHERE (phone = $1 OR name = $1) AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUser(ctx context.Context, phone string) (*User, error) {
@@ -113,6 +131,7 @@ func (q *Queries) GetUser(ctx context.Context, phone string) (*User, error) {
 		&i.DeletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
 	)
 	return &i, err
 }
@@ -123,6 +142,20 @@ SELECT
 FROM access_rules
 JOIN user_access_rules ON user_access_rules.rule_id = access_rules.id
 WHERE user_access_rules.user_id = $1
+UNION
+SELECT
+    access_rules.name
+FROM access_rules
+JOIN team_access_rules ON team_access_rules.rule_id = access_rules.id
+JOIN team_members ON team_members.team_id = team_access_rules.team_id
+WHERE team_members.user_id = $1
+UNION
+SELECT
+    access_rules.name
+FROM access_rules
+JOIN role_access_rules ON role_access_rules.rule_id = access_rules.id
+JOIN user_roles ON user_roles.role_id = role_access_rules.role_id
+WHERE user_roles.user_id = $1
 `
 
 func (q *Queries) GetUserAccessRuleNames(ctx context.Context, userID uuid.UUID) ([]string, error) {
@@ -145,28 +178,148 @@ func (q *Queries) GetUserAccessRuleNames(ctx context.Context, userID uuid.UUID)
 	return items, nil
 }
 
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, name, phone, password_hash, password_salt, refresh_token, org_id, deleted_at, created_at, updated_at, version FROM users WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Phone,
+		&i.PasswordHash,
+		&i.PasswordSalt,
+		&i.RefreshToken,
+		&i.OrgID,
+		&i.DeletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+	)
+	return &i, err
+}
+
 const isPhoneExist = `-- name: IsPhoneExist :one
-SELECT EXISTS (SELECT 1 FROM users WHERE phone = $1) AS exist
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE phone = $1 AND (deleted_at IS NULL OR deleted_at > $2::TIMESTAMPTZ)
+) AS exist
 `
 
-func (q *Queries) IsPhoneExist(ctx context.Context, phone string) (bool, error) {
-	row := q.db.QueryRow(ctx, isPhoneExist, phone)
+type IsPhoneExistParams struct {
+	Phone        string
+	DeletedAfter time.Time
+}
+
+func (q *Queries) IsPhoneExist(ctx context.Context, arg IsPhoneExistParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isPhoneExist, arg.Phone, arg.DeletedAfter)
 	var exist bool
 	err := row.Scan(&exist)
 	return exist, err
 }
 
+const isUserActive = `-- name: IsUserActive :one
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL
+) AS active
+`
+
+func (q *Queries) IsUserActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserActive, id)
+	var active bool
+	err := row.Scan(&active)
+	return active, err
+}
+
 const isUsernameExist = `-- name: IsUsernameExist :one
-SELECT EXISTS (SELECT 1 FROM users WHERE name = $1) AS exist
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE name = $1 AND (deleted_at IS NULL OR deleted_at > $2::TIMESTAMPTZ)
+) AS exist
 `
 
-func (q *Queries) IsUsernameExist(ctx context.Context, name string) (bool, error) {
-	row := q.db.QueryRow(ctx, isUsernameExist, name)
+type IsUsernameExistParams struct {
+	Name         string
+	DeletedAfter time.Time
+}
+
+func (q *Queries) IsUsernameExist(ctx context.Context, arg IsUsernameExistParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isUsernameExist, arg.Name, arg.DeletedAfter)
 	var exist bool
 	err := row.Scan(&exist)
 	return exist, err
 }
 
+const listOrgMembers = `-- name: ListOrgMembers :many
+SELECT
+    id,
+    name,
+    phone,
+    created_at
+FROM users
+WHERE org_id = $1 AND deleted_at IS NULL
+    AND ($2::TEXT IS NULL OR name LIKE '%' || $2 || '%' OR phone LIKE '%' || $2 || '%')
+    AND (
+        $3::TIMESTAMPTZ IS NULL
+        OR (NOT $4::BOOLEAN AND (created_at, id) > ($3, $5::UUID))
+        OR ($4::BOOLEAN AND (created_at, id) < ($3, $5::UUID))
+    )
+ORDER BY
+    CASE WHEN $4::BOOLEAN THEN created_at END DESC,
+    CASE WHEN $4::BOOLEAN THEN id END DESC,
+    created_at,
+    id
+LIMIT $6::INTEGER
+`
+
+type ListOrgMembersParams struct {
+	OrgID           uuid.UUID
+	Keyword         *string
+	CursorCreatedAt *time.Time
+	Descending      bool
+	CursorID        uuid.NullUUID
+	RowLimit        int32
+}
+
+type ListOrgMembersRow struct {
+	ID        uuid.UUID
+	Name      string
+	Phone     string
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListOrgMembers(ctx context.Context, arg ListOrgMembersParams) ([]*ListOrgMembersRow, error) {
+	rows, err := q.db.Query(ctx, listOrgMembers,
+		arg.OrgID,
+		arg.Keyword,
+		arg.CursorCreatedAt,
+		arg.Descending,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListOrgMembersRow
+	for rows.Next() {
+		var i ListOrgMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Phone,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markPhoneCodeUsed = `-- name: MarkPhoneCodeUsed :exec
 UPDATE phone_code SET used = TRUE WHERE phone = $1 AND typ = $2
 `
@@ -181,8 +334,41 @@ func (q *Queries) MarkPhoneCodeUsed(ctx context.Context, arg MarkPhoneCodeUsedPa
 	return err
 }
 
+const purgeDeletedUsers = `-- name: PurgeDeletedUsers :execrows
+DELETE FROM users WHERE deleted_at < $1::TIMESTAMPTZ
+`
+
+func (q *Queries) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedUsers, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeExpiredPhoneCodes = `-- name: PurgeExpiredPhoneCodes :execrows
+DELETE FROM phone_code WHERE expired_at < $1::TIMESTAMPTZ
+`
+
+func (q *Queries) PurgeExpiredPhoneCodes(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeExpiredPhoneCodes, expiredBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteUser = `-- name: SoftDeleteUser :exec
+UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteUser(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, softDeleteUser, id)
+	return err
+}
+
 const updateUserPasswordByPhone = `-- name: UpdateUserPasswordByPhone :exec
-UPDATE users SET password_hash = $2, password_salt = $3 WHERE phone = $1
+UPDATE users SET password_hash = $2, password_salt = $3 WHERE phone = $1 AND deleted_at IS NULL
 `
 
 type UpdateUserPasswordByPhoneParams struct {
@@ -204,7 +390,7 @@ INSERT INTO phone_code (
     expired_at,
     updated_at
 ) VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP) 
-ON CONFLICT (phone, typ) DO UPDATE SET code = $3, expired_at = $4, used = FALSE, updated_at = CURRENT_TIMESTAMP
+ON CONFLICT (phone, typ) DO UPDATE SET code = $3, expired_at = $4, used = FALSE
 RETURNING phone, typ, code, used, updated_at, expired_at
 `
 
@@ -0,0 +1,231 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+const (
+	replicaHealthCheckInterval = 10 * time.Second
+	replicaHealthCheckTimeout  = 3 * time.Second
+)
+
+type primaryContextKey struct{}
+
+// WithPrimary routes the read-only queries run with the returned context to the primary,
+// it is used to read your own writes which may not be replicated to the replicas yet.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+func usePrimary(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryContextKey{}).(bool)
+	return v
+}
+
+// replicaSafeCalls are the keywords and the functions which may precede a parenthesis
+// in a query run on a replica. Any other function may write or take a lock, e.g.
+// nextval or pg_try_advisory_xact_lock, so the queries calling it run on the primary.
+var replicaSafeCalls = map[string]struct{}{
+	// keywords
+	"ALL": {}, "AND": {}, "ANY": {}, "AS": {}, "BY": {}, "EXISTS": {}, "FILTER": {},
+	"FROM": {}, "IN": {}, "JOIN": {}, "NOT": {}, "ON": {}, "OR": {}, "OVER": {},
+	"SELECT": {}, "SOME": {}, "THEN": {}, "VALUES": {}, "WHEN": {}, "WHERE": {},
+	// types with modifiers
+	"CHAR": {}, "DECIMAL": {}, "NUMERIC": {}, "VARCHAR": {},
+	// functions
+	"ARRAY_AGG": {}, "AVG": {}, "CARDINALITY": {}, "COALESCE": {}, "COUNT": {},
+	"DATE_TRUNC": {}, "GREATEST": {}, "LEAST": {}, "LENGTH": {}, "LOWER": {},
+	"MAX": {}, "MIN": {}, "NULLIF": {}, "SUM": {}, "UPPER": {},
+}
+
+// isReadOnlyQuery reports whether the sqlc query is a plain SELECT, which is safe to
+// run on a replica. Locking reads and the queries calling functions which may have
+// side effects must run on the primary.
+func isReadOnlyQuery(sql string) bool {
+	for {
+		sql = strings.TrimSpace(sql)
+		if !strings.HasPrefix(sql, "--") {
+			break
+		}
+		i := strings.IndexByte(sql, '\n')
+		if i == -1 {
+			return false
+		}
+		sql = sql[i+1:]
+	}
+	upper := strings.ToUpper(sql)
+	if !strings.HasPrefix(upper, "SELECT") {
+		return false
+	}
+	for _, lock := range []string{" FOR UPDATE", " FOR SHARE", " FOR NO KEY UPDATE", " FOR KEY SHARE"} {
+		if strings.Contains(upper, lock) {
+			return false
+		}
+	}
+	for i, c := range upper {
+		if c != '(' {
+			continue
+		}
+		if name := calledName(upper[:i]); len(name) > 0 {
+			if _, ok := replicaSafeCalls[name]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// calledName returns the identifier right before a parenthesis, without the schema.
+func calledName(before string) string {
+	before = strings.TrimRight(before, " \t\n")
+	start := strings.LastIndexFunc(before, func(r rune) bool {
+		return !(r == '_' || r == '.' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	name := before[start+1:]
+	if i := strings.LastIndexByte(name, '.'); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+type replica struct {
+	name    string
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// replicaSet picks the healthy replicas in round-robin.
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	done     chan struct{}
+}
+
+func newReplicaSet(replicas []*replica) *replicaSet {
+	rs := &replicaSet{replicas: replicas, done: make(chan struct{})}
+	rs.checkHealth()
+	go rs.runHealthCheck()
+	return rs
+}
+
+// pick returns the next healthy replica, nil is returned if all replicas are unhealthy.
+func (rs *replicaSet) pick() *pgxpool.Pool {
+	n := uint64(len(rs.replicas))
+	start := rs.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		r := rs.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r.pool
+		}
+	}
+	return nil
+}
+
+func (rs *replicaSet) checkHealth() {
+	for _, r := range rs.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), replicaHealthCheckTimeout)
+		err := r.pool.Ping(ctx)
+		cancel()
+		healthy := err == nil
+		if r.healthy.Swap(healthy) != healthy {
+			if healthy {
+				log.Infof("replica %s is healthy", r.name)
+			} else {
+				log.Warnf("replica %s is unhealthy: %s", r.name, err.Error())
+			}
+		}
+	}
+}
+
+func (rs *replicaSet) runHealthCheck() {
+	ticker := time.NewTicker(replicaHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rs.done:
+			return
+		case <-ticker.C:
+		}
+		rs.checkHealth()
+	}
+}
+
+// close stops the health check and closes the pools of the replicas.
+func (rs *replicaSet) close() {
+	close(rs.done)
+	for _, r := range rs.replicas {
+		r.pool.Close()
+	}
+}
+
+// routingDB sends read-only queries to the replicas and everything else to the primary,
+// read-only queries fall back to the primary when no replica is healthy or the context
+// is created by WithPrimary. Transactions are begun on the primary pool directly.
+type routingDB struct {
+	primary  *pgxpool.Pool
+	replicas *replicaSet
+}
+
+var _ querier.DBTX = &routingDB{}
+
+func (d *routingDB) route(ctx context.Context, sql string) querier.DBTX {
+	if usePrimary(ctx) || !isReadOnlyQuery(sql) {
+		return d.primary
+	}
+	if pool := d.replicas.pick(); pool != nil {
+		return pool
+	}
+	return d.primary
+}
+
+func (d *routingDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return d.primary.Exec(ctx, sql, args...)
+}
+
+func (d *routingDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return d.route(ctx, sql).Query(ctx, sql, args...)
+}
+
+func (d *routingDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return d.route(ctx, sql).QueryRow(ctx, sql, args...)
+}
+
+// newReplicas creates a pool for each replica DSN, the pools connect lazily so an
+// unavailable replica does not block the startup. A DSN listed twice gets two pools,
+// which doubles its share of the queries.
+func newReplicas(cfg *config.Pg) ([]*replica, error) {
+	replicas := make([]*replica, 0, len(cfg.Replicas))
+	closeAll := func() {
+		for _, r := range replicas {
+			r.pool.Close()
+		}
+	}
+	for i, dsn := range cfg.Replicas {
+		config, err := newPoolConfig(cfg, dsn)
+		if err != nil {
+			closeAll()
+			return nil, errors.Wrapf(err, "failed to parse config of replica %d", i)
+		}
+		pool, err := pgxpool.NewWithConfig(context.Background(), config)
+		if err != nil {
+			closeAll()
+			return nil, errors.Wrapf(err, "failed to init pgxpool of replica %d", i)
+		}
+		replicas = append(replicas, &replica{
+			name: fmt.Sprintf("%d (%s:%d/%s)", i, config.ConnConfig.Host, config.ConnConfig.Port, config.ConnConfig.Database),
+			pool: pool,
+		})
+	}
+	return replicas, nil
+}
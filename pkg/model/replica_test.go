@@ -0,0 +1,116 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+func TestIsReadOnlyQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sql      string
+		expected bool
+	}{
+		{name: "select", sql: "-- name: GetTeam :one\nSELECT id, org_id, name FROM teams WHERE id = $1 AND org_id = $2", expected: true},
+		{name: "lower case", sql: "select * from teams", expected: true},
+		{name: "exists", sql: "SELECT EXISTS (SELECT 1 FROM teams WHERE org_id = $1 AND name = $2)", expected: true},
+		{name: "aggregate", sql: "SELECT COALESCE(SUM(count), 0)::BIGINT FROM usage_daily WHERE org_id = $1 AND metric = ANY($2::TEXT[])", expected: true},
+		{name: "count", sql: "SELECT COUNT(*) FROM users WHERE org_id = $1", expected: true},
+		{name: "subquery", sql: "SELECT * FROM users WHERE id IN (SELECT user_id FROM team_members WHERE team_id = $1)", expected: true},
+		{name: "nested parenthesis", sql: "SELECT * FROM jobs WHERE ((attempts < max_attempts) AND run_at <= $1)", expected: true},
+		{name: "string", sql: "SELECT * FROM users WHERE name = 'nextval' OR phone = $1", expected: true},
+
+		{name: "insert", sql: "-- name: CreateTeam :one\nINSERT INTO teams (org_id, name) VALUES ($1, $2) RETURNING *", expected: false},
+		{name: "update", sql: "UPDATE teams SET name = $2 WHERE id = $1", expected: false},
+		{name: "delete", sql: "DELETE FROM teams WHERE id = $1", expected: false},
+		{name: "cte", sql: "WITH deleted AS (DELETE FROM jobs RETURNING *) SELECT COUNT(*) FROM deleted", expected: false},
+		{name: "for update", sql: "SELECT * FROM org_balances WHERE org_id = $1 FOR UPDATE", expected: false},
+		{name: "for share", sql: "SELECT * FROM org_balances WHERE org_id = $1 FOR SHARE", expected: false},
+		{name: "for no key update", sql: "SELECT * FROM jobs FOR NO KEY UPDATE SKIP LOCKED", expected: false},
+		{name: "advisory lock", sql: "SELECT pg_try_advisory_lock(hashtext($1))", expected: false},
+		{name: "nextval", sql: "SELECT nextval('invoice_seq')", expected: false},
+		{name: "schema qualified", sql: "SELECT pg_catalog.set_config('app.org_id', $1, false)", expected: false},
+		{name: "space before parenthesis", sql: "SELECT pg_advisory_lock ($1)", expected: false},
+		{name: "function in where", sql: "SELECT * FROM users WHERE id = $1 AND pg_notify('events', 'x') IS NOT NULL", expected: false},
+		{name: "comment only", sql: "-- name: Nothing :exec", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, isReadOnlyQuery(testCase.sql))
+		})
+	}
+}
+
+func newTestPool(t *testing.T, dsn string) *pgxpool.Pool {
+	// the pool connects lazily, no database is needed
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestRoutingDB(t *testing.T) {
+	var (
+		primary  = newTestPool(t, "postgres://postgres@primary:5432/xich")
+		replica1 = &replica{name: "1", pool: newTestPool(t, "postgres://postgres@replica1:5432/xich")}
+		replica2 = &replica{name: "2", pool: newTestPool(t, "postgres://postgres@replica2:5432/xich")}
+		db       = &routingDB{primary: primary, replicas: &replicaSet{replicas: []*replica{replica1, replica2}}}
+		ctx      = context.Background()
+		read     = "SELECT * FROM teams WHERE id = $1"
+		write    = "UPDATE teams SET name = $2 WHERE id = $1"
+		lock     = "SELECT pg_try_advisory_xact_lock($1)"
+	)
+	replica1.healthy.Store(true)
+	replica2.healthy.Store(true)
+
+	// the reads are spread over the healthy replicas
+	picked := map[any]int{}
+	for i := 0; i < 4; i++ {
+		picked[db.route(ctx, read)]++
+	}
+	assert.Equal(t, map[any]int{replica1.pool: 2, replica2.pool: 2}, picked)
+
+	assert.Same(t, primary, db.route(ctx, write))
+	assert.Same(t, primary, db.route(ctx, lock))
+	assert.Same(t, primary, db.route(WithPrimary(ctx), read))
+
+	replica1.healthy.Store(false)
+	for i := 0; i < 2; i++ {
+		assert.Same(t, replica2.pool, db.route(ctx, read))
+	}
+
+	// falls back to the primary when no replica is healthy
+	replica2.healthy.Store(false)
+	assert.Same(t, primary, db.route(ctx, read))
+}
+
+func TestNewReplicas(t *testing.T) {
+	cfg := &config.Pg{
+		Replicas: []string{
+			"postgres://postgres@replica1:5432/xich",
+			"postgres://postgres@replica2:5432/xich",
+			"postgres://postgres@replica1:5432/xich",
+		},
+	}
+	replicas, err := newReplicas(cfg)
+	require.NoError(t, err)
+	defer func() {
+		for _, r := range replicas {
+			r.pool.Close()
+		}
+	}()
+	// the same DSN listed twice gets a pool for each
+	require.Len(t, replicas, 3)
+	assert.Equal(t, "0 (replica1:5432/xich)", replicas[0].name)
+	assert.Equal(t, "2 (replica1:5432/xich)", replicas[2].name)
+	assert.NotSame(t, replicas[0].pool, replicas[2].pool)
+
+	cfg.Replicas = append(cfg.Replicas, "postgres://postgres@replica3:port/xich")
+	_, err = newReplicas(cfg)
+	assert.ErrorContains(t, err, "replica 3")
+}
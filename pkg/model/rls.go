@@ -0,0 +1,56 @@
+package model
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// the session variables read by the row-level security policies of the org tables
+const setSessionOrgSQL = `SELECT set_config('app.current_org', $1, false), set_config('app.bypass_rls', $2, false)`
+
+// OrgContextKey is the context key of the org which the queries are scoped to. Fiber
+// handlers set it with c.Locals, since fasthttp resolves context values from the user
+// values of the request.
+type OrgContextKey struct{}
+
+type bypassRLSContextKey struct{}
+
+// WithOrg scopes the queries run with the returned context to the org, rows of the
+// other orgs are invisible to them.
+func WithOrg(ctx context.Context, orgID uuid.UUID) context.Context {
+	return context.WithValue(ctx, OrgContextKey{}, orgID)
+}
+
+// WithBypassRLS lets the queries run with the returned context see the rows of all
+// orgs, it is meant for the background jobs which are not run on behalf of an org.
+func WithBypassRLS(ctx context.Context) context.Context {
+	if v, _ := ctx.Value(bypassRLSContextKey{}).(bool); v {
+		return ctx
+	}
+	return context.WithValue(ctx, bypassRLSContextKey{}, true)
+}
+
+func sessionOrg(ctx context.Context) (string, string) {
+	var org, bypass = "", "off"
+	if orgID, ok := ctx.Value(OrgContextKey{}).(uuid.UUID); ok {
+		org = orgID.String()
+	}
+	if v, _ := ctx.Value(bypassRLSContextKey{}).(bool); v {
+		bypass = "on"
+	}
+	return org, bypass
+}
+
+// setSessionOrg is run every time a connection is acquired from the pool, so that a
+// connection never carries the org of the previous request. Queries run without an
+// org nor the bypass see no rows of the org tables.
+func setSessionOrg(ctx context.Context, conn *pgx.Conn) bool {
+	org, bypass := sessionOrg(ctx)
+	if _, err := conn.Exec(ctx, setSessionOrgSQL, org, bypass); err != nil {
+		log.Warnf("failed to set the org of the session: %s", err.Error())
+		return false
+	}
+	return true
+}
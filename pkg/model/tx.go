@@ -0,0 +1,76 @@
+package model
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxRetries is how many times a transaction is retried on serialization
+	// failures and deadlocks by default.
+	DefaultMaxRetries = 3
+
+	retryBaseDelay = 20 * time.Millisecond
+	retryMaxDelay  = time.Second
+)
+
+// postgres error codes of the transactions that can be retried
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+type txOptions struct {
+	pgx.TxOptions
+	maxRetries int
+}
+
+type TxOption func(*txOptions)
+
+func WithIsolationLevel(level pgx.TxIsoLevel) TxOption {
+	return func(o *txOptions) {
+		o.IsoLevel = level
+	}
+}
+
+func WithReadOnly() TxOption {
+	return func(o *txOptions) {
+		o.AccessMode = pgx.ReadOnly
+	}
+}
+
+// WithMaxRetries sets how many times the transaction is retried, 0 disables retries.
+func WithMaxRetries(n int) TxOption {
+	return func(o *txOptions) {
+		o.maxRetries = n
+	}
+}
+
+func newTxOptions(opts []TxOption) *txOptions {
+	o := &txOptions{maxRetries: DefaultMaxRetries}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+}
+
+// retryDelay is the exponential backoff of the attempt with full jitter.
+func retryDelay(attempt int) time.Duration {
+	d := retryBaseDelay << attempt
+	if d <= 0 || d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "serialization failure", err: &pgconn.PgError{Code: pgSerializationFailure}, expected: true},
+		{name: "deadlock detected", err: &pgconn.PgError{Code: pgDeadlockDetected}, expected: true},
+		{name: "wrapped", err: errors.Wrap(&pgconn.PgError{Code: pgSerializationFailure}, "failed to commit"), expected: true},
+		{name: "fmt wrapped", err: fmt.Errorf("failed to update: %w", &pgconn.PgError{Code: pgDeadlockDetected}), expected: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, expected: false},
+		{name: "lock not available", err: &pgconn.PgError{Code: "55P03"}, expected: false},
+		{name: "no rows", err: pgx.ErrNoRows, expected: false},
+		{name: "other", err: errors.New("connection reset"), expected: false},
+		{name: "nil", err: nil, expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, isRetryable(testCase.err))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	testCases := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 0, max: 20 * time.Millisecond},
+		{attempt: 1, max: 40 * time.Millisecond},
+		{attempt: 3, max: 160 * time.Millisecond},
+		{attempt: 5, max: 640 * time.Millisecond},
+		{attempt: 6, max: retryMaxDelay},
+		{attempt: 10, max: retryMaxDelay},
+		// the shift overflows
+		{attempt: 62, max: retryMaxDelay},
+		{attempt: 64, max: retryMaxDelay},
+		{attempt: 100, max: retryMaxDelay},
+	}
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("attempt %d", testCase.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				d := retryDelay(testCase.attempt)
+				assert.GreaterOrEqual(t, d, time.Duration(0))
+				assert.Less(t, d, testCase.max)
+			}
+		})
+	}
+}
+
+func TestNewTxOptions(t *testing.T) {
+	o := newTxOptions(nil)
+	assert.Equal(t, DefaultMaxRetries, o.maxRetries)
+	assert.Equal(t, pgx.TxOptions{}, o.TxOptions)
+
+	o = newTxOptions([]TxOption{
+		WithIsolationLevel(pgx.Serializable),
+		WithReadOnly(),
+		WithMaxRetries(0),
+	})
+	assert.Equal(t, 0, o.maxRetries)
+	assert.Equal(t, pgx.Serializable, o.IsoLevel)
+	assert.Equal(t, pgx.ReadOnly, o.AccessMode)
+}
@@ -10,4 +10,7 @@ func newRule(name string) string {
 var (
 	RuleWorker = newRule("worker")
 	RuleAdmin  = newRule("admin")
+
+	// unlocked by paid plans
+	RulePremium = newRule("premium")
 )
@@ -0,0 +1,310 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/logger"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+var log = logger.NewLogAgent("outbox")
+
+const (
+	// Channel is notified when events are written to the outbox.
+	Channel = "outbox_events"
+
+	// DefaultMaxAttempts is how many times an event is delivered to a handler before the
+	// handler gives up on it.
+	DefaultMaxAttempts = 5
+
+	// handlerTimeout bounds a single delivery of an event to a handler.
+	handlerTimeout = time.Minute
+
+	// claimTimeout is how long a claimed event is hidden from the other dispatchers, it
+	// is claimed again afterwards if the dispatcher died while delivering it. It must be
+	// longer than the handlers of an event take together.
+	claimTimeout = 10 * time.Minute
+
+	// pollInterval is how often the outbox is polled without notifications, it also
+	// picks up the events whose retry delay has passed.
+	pollInterval = 30 * time.Second
+
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = 10 * time.Minute
+)
+
+type Event struct {
+	ID      int64
+	Type    string
+	Payload json.RawMessage
+	// Attempts counts the deliveries to the handler, this one included
+	Attempts int32
+}
+
+// Decode unmarshals the payload of the event into v.
+func (e *Event) Decode(v any) error {
+	return json.Unmarshal(e.Payload, v)
+}
+
+// Handler handles an event. Events are delivered at least once, so handlers must be
+// idempotent.
+type Handler func(ctx context.Context, event *Event) error
+
+// Publish writes the event to the outbox, m must be the model of the transaction making
+// the change so that the event is only delivered if the transaction commits.
+func Publish(ctx context.Context, m model.ModelInterface, typ string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal event %s", typ)
+	}
+	if err := m.CreateOutboxEvent(ctx, querier.CreateOutboxEventParams{
+		Typ:     typ,
+		Payload: raw,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create outbox event %s", typ)
+	}
+	return nil
+}
+
+type subscription struct {
+	name    string
+	handler Handler
+}
+
+// Dispatcher delivers the events in the outbox to the handlers subscribed to them.
+type Dispatcher struct {
+	m           model.ModelInterface
+	handlers    map[string][]subscription
+	maxAttempts int32
+	now         func() time.Time
+}
+
+func NewDispatcher(m model.ModelInterface) *Dispatcher {
+	return &Dispatcher{
+		m:           m,
+		handlers:    make(map[string][]subscription),
+		maxAttempts: DefaultMaxAttempts,
+		now:         time.Now,
+	}
+}
+
+// Subscribe registers the handler of the event type, it must be called before Run. The
+// deliveries of the events are tracked by the name of the handler, so it must be unique
+// among the handlers of the type and kept when the handler is changed.
+func (d *Dispatcher) Subscribe(typ string, name string, h Handler) {
+	for _, s := range d.handlers[typ] {
+		if s.name == name {
+			panic(fmt.Sprintf("handler %s of %s is already subscribed", name, typ))
+		}
+	}
+	d.handlers[typ] = append(d.handlers[typ], subscription{name: name, handler: h})
+}
+
+// Run delivers the events until ctx is done. It wakes up on the notifications of new
+// events and polls the outbox in case notifications are missed. The event being
+// delivered when ctx is done is finished before it returns.
+func (d *Dispatcher) Run(ctx context.Context) {
+	// the handlers are not run on behalf of an org
+	ctx = model.WithBypassRLS(ctx)
+
+	var l model.Listener
+	defer func() {
+		if l != nil {
+			l.Close()
+		}
+	}()
+	for {
+		if l == nil {
+			var err error
+			if l, err = d.m.Listen(ctx, Channel); err != nil {
+				log.Warnf("failed to listen on %s, polling only: %s", Channel, err.Error())
+			}
+		}
+		if err := d.dispatchDue(ctx); err != nil {
+			log.Errorf("failed to dispatch outbox events: %s", err.Error())
+		}
+		if err := d.wait(ctx, l); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warnf("lost the connection listening on %s: %s", Channel, err.Error())
+			l.Close()
+			l = nil
+		}
+	}
+}
+
+// wait blocks until a notification is received or the poll interval has passed, an
+// error is returned if the listener is broken.
+func (d *Dispatcher) wait(ctx context.Context, l model.Listener) error {
+	if l == nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+			return nil
+		}
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, pollInterval)
+	defer cancel()
+	if err := l.Wait(waitCtx); err != nil && (ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded)) {
+		return err
+	}
+	return nil
+}
+
+// DispatchAll delivers all the events which are due.
+func (d *Dispatcher) DispatchAll(ctx context.Context) error {
+	for {
+		dispatched, err := d.dispatchNext(ctx)
+		if err != nil {
+			return err
+		}
+		if !dispatched {
+			return nil
+		}
+	}
+}
+
+// dispatchDue is DispatchAll which stops early once ctx is done, the event being
+// delivered is finished regardless.
+func (d *Dispatcher) dispatchDue(ctx context.Context) error {
+	for ctx.Err() == nil {
+		dispatched, err := d.dispatchNext(context.WithoutCancel(ctx))
+		if err != nil {
+			return err
+		}
+		if !dispatched {
+			return nil
+		}
+	}
+	return nil
+}
+
+// dispatchNext delivers one due event, false is returned if there is none. The event is
+// claimed before its handlers run, so concurrent dispatchers never deliver it at the
+// same time and no transaction is held open while the handlers call external services.
+// The handlers which have not accepted the event yet are run, each of them is retried
+// and dead-lettered on its own.
+func (d *Dispatcher) dispatchNext(ctx context.Context) (bool, error) {
+	now := d.now()
+	e, err := d.m.ClaimNextOutboxEvent(ctx, querier.ClaimNextOutboxEventParams{
+		LockedUntil: now.Add(claimTimeout),
+		Now:         now,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to claim outbox event")
+	}
+
+	deliveries, err := d.m.ListOutboxDeliveries(ctx, e.ID)
+	if err != nil {
+		return true, errors.Wrap(err, "failed to list outbox deliveries")
+	}
+	previous := make(map[string]*querier.OutboxDelivery, len(deliveries))
+	for _, delivery := range deliveries {
+		previous[delivery.Handler] = delivery
+	}
+
+	var (
+		deadErr    error
+		retryErr   error
+		retryAfter time.Duration
+	)
+	for _, s := range d.handlers[e.Typ] {
+		var attempts int32 = 1
+		if p, ok := previous[s.name]; ok {
+			if p.DeliveredAt != nil {
+				continue
+			}
+			if p.DeadAt != nil {
+				deadErr = errors.Errorf("%s: dead-lettered", s.name)
+				if p.LastError != nil {
+					deadErr = errors.Errorf("%s: %s", s.name, *p.LastError)
+				}
+				continue
+			}
+			attempts = p.Attempts + 1
+		}
+
+		event := &Event{ID: e.ID, Type: e.Typ, Payload: e.Payload, Attempts: attempts}
+		params := querier.UpsertOutboxDeliveryParams{
+			EventID:  e.ID,
+			Handler:  s.name,
+			Attempts: attempts,
+		}
+		if err := d.deliver(ctx, s.handler, event); err != nil {
+			params.LastError = utils.Ptr(err.Error())
+			if attempts >= d.maxAttempts {
+				log.Errorf("dead-lettering event %d %s for %s after %d attempts: %s", e.ID, e.Typ, s.name, attempts, err.Error())
+				params.DeadAt = utils.Ptr(d.now())
+				deadErr = errors.Wrap(err, s.name)
+			} else {
+				log.Warnf("failed to deliver event %d %s to %s (%d/%d): %s", e.ID, e.Typ, s.name, attempts, d.maxAttempts, err.Error())
+				if delay := retryDelay(attempts); retryErr == nil || delay < retryAfter {
+					retryAfter = delay
+				}
+				retryErr = errors.Wrap(err, s.name)
+			}
+		} else {
+			params.DeliveredAt = utils.Ptr(d.now())
+		}
+		if err := d.m.UpsertOutboxDelivery(ctx, params); err != nil {
+			return true, errors.Wrapf(err, "failed to record delivery of outbox event %d to %s", e.ID, s.name)
+		}
+	}
+
+	switch {
+	case retryErr != nil:
+		if err := d.m.MarkOutboxEventFailed(ctx, querier.MarkOutboxEventFailedParams{
+			ID:          e.ID,
+			LastError:   utils.Ptr(retryErr.Error()),
+			AvailableAt: d.now().Add(retryAfter),
+		}); err != nil {
+			return true, errors.Wrap(err, "failed to mark outbox event failed")
+		}
+	case deadErr != nil:
+		if err := d.m.MarkOutboxEventDead(ctx, querier.MarkOutboxEventDeadParams{
+			ID:        e.ID,
+			LastError: utils.Ptr(deadErr.Error()),
+		}); err != nil {
+			return true, errors.Wrap(err, "failed to mark outbox event dead")
+		}
+	default:
+		if err := d.m.MarkOutboxEventDispatched(ctx, e.ID); err != nil {
+			return true, errors.Wrap(err, "failed to mark outbox event dispatched")
+		}
+	}
+	return true, nil
+}
+
+// deliver runs the handler within the handler timeout, panics are returned as errors so
+// that the delivery is retried.
+func (d *Dispatcher) deliver(ctx context.Context, h Handler, event *Event) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, handlerTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return h(ctx, event)
+}
+
+// retryDelay doubles the delay on every failed attempt.
+func retryDelay(attempts int32) time.Duration {
+	delay := retryBaseDelay << (attempts - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,185 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+func newTestDispatcher(t *testing.T) (*Dispatcher, *model.MockModelInterface, time.Time) {
+	ctrl := gomock.NewController(t)
+	m := model.NewMockModelInterface(ctrl)
+	now := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	d := NewDispatcher(m)
+	d.now = func() time.Time { return now }
+	return d, m, now
+}
+
+func expectClaim(m *model.MockModelInterface, now time.Time, e *querier.OutboxEvent) {
+	m.EXPECT().ClaimNextOutboxEvent(gomock.Any(), querier.ClaimNextOutboxEventParams{
+		LockedUntil: now.Add(claimTimeout),
+		Now:         now,
+	}).Return(e, nil)
+}
+
+func TestDispatchPerHandler(t *testing.T) {
+	d, m, now := newTestDispatcher(t)
+	ctx := context.Background()
+
+	var delivered []string
+	d.Subscribe("org.created", "balance", func(ctx context.Context, event *Event) error {
+		delivered = append(delivered, "balance")
+		return nil
+	})
+	d.Subscribe("org.created", "robot", func(ctx context.Context, event *Event) error {
+		delivered = append(delivered, "robot")
+		if event.Attempts < 2 {
+			return errors.New("timeout")
+		}
+		return nil
+	})
+	event := &querier.OutboxEvent{ID: 1, Typ: "org.created", Payload: []byte(`{}`), Attempts: 1}
+
+	// the failing handler is retried, the other one is recorded as delivered
+	expectClaim(m, now, event)
+	m.EXPECT().ListOutboxDeliveries(gomock.Any(), int64(1)).Return(nil, nil)
+	m.EXPECT().UpsertOutboxDelivery(gomock.Any(), querier.UpsertOutboxDeliveryParams{
+		EventID:     1,
+		Handler:     "balance",
+		Attempts:    1,
+		DeliveredAt: &now,
+	}).Return(nil)
+	m.EXPECT().UpsertOutboxDelivery(gomock.Any(), querier.UpsertOutboxDeliveryParams{
+		EventID:   1,
+		Handler:   "robot",
+		Attempts:  1,
+		LastError: utils.Ptr("timeout"),
+	}).Return(nil)
+	m.EXPECT().MarkOutboxEventFailed(gomock.Any(), querier.MarkOutboxEventFailedParams{
+		ID:          1,
+		LastError:   utils.Ptr("robot: timeout"),
+		AvailableAt: now.Add(retryBaseDelay),
+	}).Return(nil)
+
+	dispatched, err := d.dispatchNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, dispatched)
+	assert.Equal(t, []string{"balance", "robot"}, delivered)
+
+	// only the failed handler runs on the retry
+	delivered = nil
+	expectClaim(m, now, event)
+	m.EXPECT().ListOutboxDeliveries(gomock.Any(), int64(1)).Return([]*querier.OutboxDelivery{
+		{EventID: 1, Handler: "balance", Attempts: 1, DeliveredAt: &now},
+		{EventID: 1, Handler: "robot", Attempts: 1, LastError: utils.Ptr("timeout")},
+	}, nil)
+	m.EXPECT().UpsertOutboxDelivery(gomock.Any(), querier.UpsertOutboxDeliveryParams{
+		EventID:     1,
+		Handler:     "robot",
+		Attempts:    2,
+		DeliveredAt: &now,
+	}).Return(nil)
+	m.EXPECT().MarkOutboxEventDispatched(gomock.Any(), int64(1)).Return(nil)
+
+	dispatched, err = d.dispatchNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, dispatched)
+	assert.Equal(t, []string{"robot"}, delivered)
+}
+
+func TestDispatchDeadLetter(t *testing.T) {
+	d, m, now := newTestDispatcher(t)
+	ctx := context.Background()
+
+	d.Subscribe("user.registered", "robot", func(ctx context.Context, event *Event) error {
+		return errors.New("timeout")
+	})
+	d.Subscribe("user.registered", "webhooks", func(ctx context.Context, event *Event) error {
+		panic("nil map")
+	})
+
+	// the handler out of attempts is dead-lettered while the other one is still retried
+	expectClaim(m, now, &querier.OutboxEvent{ID: 2, Typ: "user.registered", Payload: []byte(`{}`), Attempts: 5})
+	m.EXPECT().ListOutboxDeliveries(gomock.Any(), int64(2)).Return([]*querier.OutboxDelivery{
+		{EventID: 2, Handler: "robot", Attempts: DefaultMaxAttempts - 1},
+		{EventID: 2, Handler: "webhooks", Attempts: 2},
+	}, nil)
+	m.EXPECT().UpsertOutboxDelivery(gomock.Any(), querier.UpsertOutboxDeliveryParams{
+		EventID:   2,
+		Handler:   "robot",
+		Attempts:  DefaultMaxAttempts,
+		LastError: utils.Ptr("timeout"),
+		DeadAt:    &now,
+	}).Return(nil)
+	m.EXPECT().UpsertOutboxDelivery(gomock.Any(), querier.UpsertOutboxDeliveryParams{
+		EventID:   2,
+		Handler:   "webhooks",
+		Attempts:  3,
+		LastError: utils.Ptr("panic: nil map"),
+	}).Return(nil)
+	m.EXPECT().MarkOutboxEventFailed(gomock.Any(), querier.MarkOutboxEventFailedParams{
+		ID:          2,
+		LastError:   utils.Ptr("webhooks: panic: nil map"),
+		AvailableAt: now.Add(retryDelay(3)),
+	}).Return(nil)
+
+	dispatched, err := d.dispatchNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, dispatched)
+
+	// the event is dead once no handler is left to retry
+	expectClaim(m, now, &querier.OutboxEvent{ID: 2, Typ: "user.registered", Payload: []byte(`{}`), Attempts: 6})
+	m.EXPECT().ListOutboxDeliveries(gomock.Any(), int64(2)).Return([]*querier.OutboxDelivery{
+		{EventID: 2, Handler: "robot", Attempts: DefaultMaxAttempts, LastError: utils.Ptr("timeout"), DeadAt: &now},
+		{EventID: 2, Handler: "webhooks", Attempts: DefaultMaxAttempts, LastError: utils.Ptr("panic: nil map"), DeliveredAt: &now},
+	}, nil)
+	m.EXPECT().MarkOutboxEventDead(gomock.Any(), querier.MarkOutboxEventDeadParams{
+		ID:        2,
+		LastError: utils.Ptr("robot: timeout"),
+	}).Return(nil)
+
+	dispatched, err = d.dispatchNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, dispatched)
+}
+
+func TestDispatchAll(t *testing.T) {
+	d, m, now := newTestDispatcher(t)
+	ctx := context.Background()
+
+	// events without handlers are dispatched right away
+	expectClaim(m, now, &querier.OutboxEvent{ID: 3, Typ: "unknown", Payload: []byte(`{}`), Attempts: 1})
+	m.EXPECT().ListOutboxDeliveries(gomock.Any(), int64(3)).Return(nil, nil)
+	m.EXPECT().MarkOutboxEventDispatched(gomock.Any(), int64(3)).Return(nil)
+	m.EXPECT().ClaimNextOutboxEvent(gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows)
+
+	require.NoError(t, d.DispatchAll(ctx))
+}
+
+func TestSubscribeDuplicate(t *testing.T) {
+	d, _, _ := newTestDispatcher(t)
+	h := func(ctx context.Context, event *Event) error { return nil }
+
+	d.Subscribe("org.created", "balance", h)
+	d.Subscribe("user.registered", "balance", h)
+	assert.Panics(t, func() {
+		d.Subscribe("org.created", "balance", h)
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, retryDelay(1))
+	assert.Equal(t, 10*time.Second, retryDelay(2))
+	assert.Equal(t, 80*time.Second, retryDelay(5))
+	assert.Equal(t, retryMaxDelay, retryDelay(10))
+	assert.Equal(t, retryMaxDelay, retryDelay(64))
+}
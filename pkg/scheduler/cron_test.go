@@ -0,0 +1,33 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 30, 5, 0, time.UTC)
+
+	// 0 12 1 * mon fires on the 1st or on Mondays
+	schedule, err := Parse("0 12 1 * mon")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), schedule.Next(now))
+	assert.Equal(t, time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC), schedule.Next(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)))
+
+	schedule, err = Parse("*/20 9-17/4 * * *")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC), schedule.Next(now))
+	assert.Equal(t, time.Date(2024, 3, 1, 13, 40, 0, 0, time.UTC), schedule.Next(time.Date(2024, 3, 1, 13, 20, 0, 0, time.UTC)))
+
+	schedule, err = Parse("@hourly")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC), schedule.Next(now))
+
+	for _, spec := range []string{"* * * *", "60 * * * *", "0 0 30 2 *", "5-1 * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,221 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	// the time zone database is embedded for the images without one
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/logger"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+var log = logger.NewLogAgent("scheduler")
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+const (
+	// Off disables a task in the config
+	Off = "off"
+
+	// historyRetention is how long the runs of the tasks are kept
+	historyRetention = 30 * 24 * time.Hour
+)
+
+// Task is the work of a scheduled task, it is run on one replica per scheduled time.
+type Task func(ctx context.Context) error
+
+type task struct {
+	name     string
+	spec     string
+	schedule *Schedule
+	run      Task
+}
+
+// Scheduler runs the registered tasks at the times given by the cron expressions of
+// the config. Every replica runs a scheduler, the replicas elect the one which runs a
+// task with a session level Postgres advisory lock, and the run is recorded so that a
+// replica firing the same time a bit later skips it.
+type Scheduler struct {
+	m         model.ModelInterface
+	schedules map[string]*Schedule
+	specs     map[string]string
+	loc       *time.Location
+	tasks     []*task
+	now       func() time.Time
+}
+
+func NewScheduler(cfg *config.Config, m model.ModelInterface) (*Scheduler, error) {
+	loc, err := time.LoadLocation(cfg.Scheduler.Timezone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load time zone %s", cfg.Scheduler.Timezone)
+	}
+	s := &Scheduler{
+		m:         m,
+		schedules: make(map[string]*Schedule),
+		specs:     make(map[string]string),
+		loc:       loc,
+		now:       time.Now,
+	}
+	for name, spec := range cfg.Scheduler.Tasks {
+		if spec == Off {
+			continue
+		}
+		schedule, err := Parse(spec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid schedule of task %s", name)
+		}
+		s.schedules[name] = schedule
+		s.specs[name] = spec
+	}
+	return s, nil
+}
+
+// Register registers the task with the schedule of the config, it must be called
+// before Run. Tasks which are turned off or not scheduled in the config are skipped.
+func (s *Scheduler) Register(name string, run Task) {
+	schedule, ok := s.schedules[name]
+	if !ok {
+		log.Infof("task %s is not scheduled", name)
+		return
+	}
+	s.tasks = append(s.tasks, &task{name: name, spec: s.specs[name], schedule: schedule, run: run})
+}
+
+// Run runs the tasks on schedule until ctx is done, it returns once the running tasks
+// are finished.
+func (s *Scheduler) Run(ctx context.Context) {
+	// the tasks are not run on behalf of an org
+	ctx = model.WithBypassRLS(ctx)
+
+	registered := make(map[string]struct{})
+	for _, t := range s.tasks {
+		registered[t.name] = struct{}{}
+	}
+	var unknown []string
+	for name := range s.schedules {
+		if _, ok := registered[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		log.Warnf("task %s is scheduled in the config but not registered", name)
+	}
+
+	var wg sync.WaitGroup
+	for _, t := range s.tasks {
+		wg.Add(1)
+		go func(t *task) {
+			defer wg.Done()
+			s.loop(ctx, t)
+		}(t)
+	}
+	wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, t *task) {
+	log.Infof("scheduled task %s at %s", t.name, t.spec)
+	for {
+		next := t.schedule.Next(s.now().In(s.loc))
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if err := s.RunTask(context.WithoutCancel(ctx), t.name, next); err != nil {
+			log.Errorf("failed to run task %s: %s", t.name, err.Error())
+		}
+	}
+}
+
+// RunTask runs the task scheduled at scheduledAt unless another replica is running it
+// or has run it. The failure of the task itself is recorded in the history rather than
+// returned.
+func (s *Scheduler) RunTask(ctx context.Context, name string, scheduledAt time.Time) error {
+	var t *task
+	for _, registered := range s.tasks {
+		if registered.name == name {
+			t = registered
+		}
+	}
+	if t == nil {
+		return errors.Errorf("task %s is not registered", name)
+	}
+	// the lock is held by a session rather than a transaction, so that no transaction
+	// is open while the task runs and the task is never run again by a retry
+	lock, locked, err := s.m.TryAdvisoryLock(ctx, "scheduled_task:"+name)
+	if err != nil {
+		return errors.Wrap(err, "failed to lock task")
+	}
+	if !locked {
+		log.Infof("task %s is run by another replica", name)
+		return nil
+	}
+	defer lock.Unlock()
+
+	// the replicas may lag behind the run recorded by another replica
+	done, err := s.m.IsScheduledTaskRun(model.WithPrimary(ctx), querier.IsScheduledTaskRunParams{
+		Task:        name,
+		ScheduledAt: scheduledAt,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to check task run")
+	}
+	if done {
+		return nil
+	}
+
+	startedAt := s.now()
+	status := StatusSucceeded
+	var lastError *string
+	if err := runTask(ctx, t); err != nil {
+		log.Errorf("task %s failed: %s", name, err.Error())
+		status = StatusFailed
+		lastError = utils.Ptr(err.Error())
+	}
+	finishedAt := s.now()
+
+	// only the recording is retried on a conflict, the task has run already
+	return s.m.RunTransaction(ctx, func(m model.ModelInterface) error {
+		if _, err := m.CreateScheduledTaskRun(ctx, querier.CreateScheduledTaskRunParams{
+			Task:        name,
+			ScheduledAt: scheduledAt,
+			Status:      status,
+			Error:       lastError,
+			StartedAt:   startedAt,
+			FinishedAt:  finishedAt,
+		}); err != nil {
+			return errors.Wrap(err, "failed to record task run")
+		}
+		if _, err := m.PurgeScheduledTaskRuns(ctx, querier.PurgeScheduledTaskRunsParams{
+			Task:          name,
+			CreatedBefore: finishedAt.Add(-historyRetention),
+		}); err != nil {
+			return errors.Wrap(err, "failed to purge task runs")
+		}
+		return nil
+	})
+}
+
+// runTask runs the task, panics are returned as errors so that they are recorded.
+func runTask(ctx context.Context, t *task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return t.run(ctx)
+}
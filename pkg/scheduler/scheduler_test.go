@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+type fakeLock struct {
+	unlocked bool
+}
+
+func (l *fakeLock) Unlock() {
+	l.unlocked = true
+}
+
+func TestRunTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var (
+		ctx         = context.Background()
+		now         = time.Date(2024, 3, 1, 0, 30, 5, 0, time.UTC)
+		scheduledAt = time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+		key         = "scheduled_task:purge"
+	)
+
+	m := model.NewExtendedMockModelInterface(ctrl)
+	s, err := NewScheduler(&config.Config{Scheduler: config.Scheduler{
+		Tasks:    map[string]string{"purge": "*/30 * * * *", "billing": Off},
+		Timezone: "Asia/Shanghai",
+	}}, m)
+	require.NoError(t, err)
+	s.now = func() time.Time { return now }
+
+	var (
+		runs    int
+		taskErr error
+	)
+	s.Register("purge", func(ctx context.Context) error {
+		runs++
+		return taskErr
+	})
+	s.Register("billing", func(ctx context.Context) error {
+		return nil
+	})
+
+	// the task is run and recorded
+	lock := &fakeLock{}
+	m.EXPECT().TryAdvisoryLock(ctx, key).Return(lock, true, nil)
+	m.EXPECT().IsScheduledTaskRun(model.WithPrimary(ctx), querier.IsScheduledTaskRunParams{
+		Task:        "purge",
+		ScheduledAt: scheduledAt,
+	}).Return(false, nil)
+	m.EXPECT().CreateScheduledTaskRun(ctx, querier.CreateScheduledTaskRunParams{
+		Task:        "purge",
+		ScheduledAt: scheduledAt,
+		Status:      StatusSucceeded,
+		StartedAt:   now,
+		FinishedAt:  now,
+	}).Return(&querier.ScheduledTaskRun{}, nil)
+	m.EXPECT().PurgeScheduledTaskRuns(ctx, querier.PurgeScheduledTaskRunsParams{
+		Task:          "purge",
+		CreatedBefore: now.Add(-historyRetention),
+	}).Return(int64(0), nil)
+	require.NoError(t, s.RunTask(ctx, "purge", scheduledAt))
+	assert.Equal(t, 1, runs)
+	assert.True(t, lock.unlocked)
+
+	// another replica holds the lock
+	m.EXPECT().TryAdvisoryLock(ctx, key).Return(nil, false, nil)
+	require.NoError(t, s.RunTask(ctx, "purge", scheduledAt))
+	assert.Equal(t, 1, runs)
+
+	// another replica has run it
+	lock = &fakeLock{}
+	m.EXPECT().TryAdvisoryLock(ctx, key).Return(lock, true, nil)
+	m.EXPECT().IsScheduledTaskRun(gomock.Any(), gomock.Any()).Return(true, nil)
+	require.NoError(t, s.RunTask(ctx, "purge", scheduledAt))
+	assert.Equal(t, 1, runs)
+	assert.True(t, lock.unlocked)
+
+	// the failure is recorded
+	taskErr = errors.New("timeout")
+	lock = &fakeLock{}
+	m.EXPECT().TryAdvisoryLock(ctx, key).Return(lock, true, nil)
+	m.EXPECT().IsScheduledTaskRun(gomock.Any(), gomock.Any()).Return(false, nil)
+	m.EXPECT().CreateScheduledTaskRun(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.CreateScheduledTaskRunParams) (*querier.ScheduledTaskRun, error) {
+			assert.Equal(t, StatusFailed, arg.Status)
+			assert.Equal(t, "timeout", *arg.Error)
+			return &querier.ScheduledTaskRun{}, nil
+		},
+	)
+	m.EXPECT().PurgeScheduledTaskRuns(ctx, gomock.Any()).Return(int64(0), nil)
+	require.NoError(t, s.RunTask(ctx, "purge", scheduledAt))
+	assert.Equal(t, 2, runs)
+	assert.True(t, lock.unlocked)
+
+	// failing to lock runs nothing
+	m.EXPECT().TryAdvisoryLock(ctx, key).Return(nil, false, errors.New("connection refused"))
+	assert.Error(t, s.RunTask(ctx, "purge", scheduledAt))
+	assert.Equal(t, 2, runs)
+
+	// turned off in the config
+	assert.Error(t, s.RunTask(ctx, "billing", scheduledAt))
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+const (
+	// AccountDeletionGracePeriod is how long the phone and username of a deleted account
+	// stay reserved before they can be registered again.
+	AccountDeletionGracePeriod = 7 * 24 * time.Hour
+
+	// AccountRetentionPeriod is how long a deleted account is kept before it is purged.
+	AccountRetentionPeriod = 30 * 24 * time.Hour
+)
+
+// DeleteAccount soft deletes the user after confirming the password. The org owned by
+// the user is deleted along with it, which is refused while other members remain.
+func (s *Service) DeleteAccount(ctx context.Context, userID uuid.UUID, password string) error {
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		user, err := model.GetUserByID(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUsernameOrPhoneNotFound
+			}
+			return errors.Wrap(err, "failed to get user")
+		}
+		inputHashPassword, err := utils.HashPassword(password, user.PasswordSalt)
+		if err != nil {
+			return errors.Wrap(err, "failed to hash password")
+		}
+		if inputHashPassword != user.PasswordHash {
+			return ErrIncorrectPassword
+		}
+
+		org, err := model.GetOrgInfoByOrgId(ctx, user.OrgID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return errors.Wrap(err, "failed to get org")
+		}
+		if err == nil && org.OwnerID.Valid && org.OwnerID.UUID == user.ID {
+			members, err := model.CountOtherOrgMembers(ctx, querier.CountOtherOrgMembersParams{
+				OrgID: org.ID,
+				ID:    user.ID,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to count org members")
+			}
+			if members > 0 {
+				return ErrOrgHasMembers
+			}
+			// stop billing the org before it goes away
+			if err := model.DeleteOrgSubscription(ctx, org.ID); err != nil {
+				return errors.Wrap(err, "failed to delete org subscription")
+			}
+			if err := model.SoftDeleteOrg(ctx, org.ID); err != nil {
+				return errors.Wrap(err, "failed to delete org")
+			}
+		}
+
+		if err := model.SoftDeleteUser(ctx, user.ID); err != nil {
+			return errors.Wrap(err, "failed to delete user")
+		}
+		return nil
+	})
+}
+
+// IsUserActive returns false if the user has been deleted, the tokens issued to a
+// deleted user are rejected with it. It reads from the primary, so the token stops
+// working as soon as the account is deleted.
+func (s *Service) IsUserActive(ctx context.Context, userID uuid.UUID) (bool, error) {
+	active, err := s.m.IsUserActive(model.WithPrimary(ctx), userID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check if user is active")
+	}
+	return active, nil
+}
+
+// PurgeDeletedAccounts hard deletes the users and orgs deleted longer than the retention
+// period ago. Orgs with ledger history or statements are kept for bookkeeping.
+func (s *Service) PurgeDeletedAccounts(ctx context.Context) error {
+	// the ledger history of all orgs must be visible to keep it from being purged
+	ctx = model.WithBypassRLS(ctx)
+	deletedBefore := s.now().Add(-AccountRetentionPeriod)
+	users, err := s.m.PurgeDeletedUsers(ctx, deletedBefore)
+	if err != nil {
+		return errors.Wrap(err, "failed to purge deleted users")
+	}
+	orgs, err := s.m.PurgeDeletedOrgs(ctx, deletedBefore)
+	if err != nil {
+		return errors.Wrap(err, "failed to purge deleted orgs")
+	}
+	if users > 0 || orgs > 0 {
+		log.Infof("purged %d deleted users and %d deleted orgs", users, orgs)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/cloud/dingtalk"
+)
+
+// DingTalk events we subscribe to, see the EventType field of the decrypted payload.
+const (
+	DdWorkEventCheckUrl           DdWorkEvent = "check_url"
+	DdWorkEventUserAddOrg         DdWorkEvent = "user_add_org"
+	DdWorkEventUserModifyOrg      DdWorkEvent = "user_modify_org"
+	DdWorkEventUserLeaveOrg       DdWorkEvent = "user_leave_org"
+	DdWorkEventBpmsInstanceChange DdWorkEvent = "bpms_instance_change"
+)
+
+// the plain text DingTalk expects in the encrypted response once an event is handled
+const ddCallbackSuccess = "success"
+
+// HandleDingTalkCallback verifies and decrypts the event pushed by DingTalk and
+// returns the encrypted acknowledgement. Events are acknowledged even if they are
+// not handled, otherwise DingTalk keeps retrying them.
+func (s *Service) HandleDingTalkCallback(ctx context.Context, signature, timestamp, nonce string, body []byte) (*apigen.DingTalkCallbackResponse, error) {
+	event, err := s.dingtalk.ParseEvent(signature, timestamp, nonce, body)
+	if err != nil {
+		if errors.Is(err, dingtalk.ErrInvalidSignature) {
+			return nil, errors.Wrap(ErrInvalidDingTalkCallback, err.Error())
+		}
+		return nil, errors.Wrap(err, "failed to parse dingtalk event")
+	}
+
+	if err := s.handleDingTalkEvent(ctx, DdWorkEvent(event.Type), event); err != nil {
+		return nil, err
+	}
+
+	res, err := s.dingtalk.EncryptResponse(ddCallbackSuccess)
+	if err != nil {
+		return nil, err
+	}
+	return &apigen.DingTalkCallbackResponse{
+		MsgSignature: res.MsgSignature,
+		TimeStamp:    res.TimeStamp,
+		Nonce:        res.Nonce,
+		Encrypt:      res.Encrypt,
+	}, nil
+}
+
+// handleDingTalkEvent decodes the event into the typed event of its type and handles it.
+func (s *Service) handleDingTalkEvent(ctx context.Context, typ DdWorkEvent, event *dingtalk.Event) error {
+	switch typ {
+	case DdWorkEventCheckUrl:
+		return nil
+	case DdWorkEventUserAddOrg, DdWorkEventUserModifyOrg, DdWorkEventUserLeaveOrg:
+		var e dingtalk.UserChangeEvent
+		if err := event.Decode(&e); err != nil {
+			return err
+		}
+		s.handleDingTalkUserChange(ctx, typ, &e)
+	case DdWorkEventBpmsInstanceChange:
+		var e dingtalk.BpmsInstanceChangeEvent
+		if err := event.Decode(&e); err != nil {
+			return err
+		}
+		s.handleDingTalkBpmsInstanceChange(ctx, &e)
+	default:
+		log.Infof("unhandled dingtalk event %s: %s", event.Type, string(event.Raw))
+	}
+	return nil
+}
+
+func (s *Service) handleDingTalkUserChange(ctx context.Context, typ DdWorkEvent, e *dingtalk.UserChangeEvent) {
+	log.Infof("dingtalk %s of corp %s: %s", typ, e.CorpID, strings.Join(e.UserIDs, ","))
+}
+
+func (s *Service) handleDingTalkBpmsInstanceChange(ctx context.Context, e *dingtalk.BpmsInstanceChangeEvent) {
+	log.Infof("dingtalk approval %s (%s) of corp %s: %s %s", e.ProcessInstanceID, e.Title, e.CorpID, e.Type, e.Result)
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+)
+
+// Domain events published to the outbox, their payloads are the structs below.
+const (
+	EventUserRegistered  = "user.registered"
+	EventPasswordChanged = "user.password_changed"
+	EventOrgCreated      = "org.created"
+	EventBalanceChanged  = "org.balance_changed"
+	EventWebhookDelivery = "webhook.delivery"
+)
+
+type UserRegisteredEvent struct {
+	UserID   uuid.UUID `json:"userId"`
+	OrgID    uuid.UUID `json:"orgId"`
+	Username string    `json:"username"`
+	Phone    string    `json:"phone"`
+}
+
+type PasswordChangedEvent struct {
+	Phone string `json:"phone"`
+}
+
+type OrgCreatedEvent struct {
+	OrgID   uuid.UUID `json:"orgId"`
+	OwnerID uuid.UUID `json:"ownerId"`
+	Name    string    `json:"name"`
+}
+
+type BalanceChangedEvent struct {
+	OrgID         uuid.UUID `json:"orgId"`
+	TransactionID uuid.UUID `json:"transactionId"`
+	Type          TradeType `json:"type"`
+	// cents moved into the balance, negative if moved out of it
+	Amount  int64 `json:"amount"`
+	Balance int64 `json:"balance"`
+}
+
+type WebhookDeliveryEvent struct {
+	DeliveryID uuid.UUID `json:"deliveryId"`
+}
+
+// RegisterEventHandlers subscribes the handlers of the domain events to the dispatcher.
+func (s *Service) RegisterEventHandlers(d *outbox.Dispatcher) {
+	d.Subscribe(EventOrgCreated, "init_balance", s.handleOrgCreated)
+	d.Subscribe(EventUserRegistered, "notify_robot", s.handleUserRegistered)
+	d.Subscribe(EventPasswordChanged, "log", s.handlePasswordChanged)
+
+	// webhooks
+	d.Subscribe(EventUserRegistered, "webhooks", s.handleUserRegisteredWebhooks)
+	d.Subscribe(EventBalanceChanged, "webhooks", s.handleBalanceChangedWebhooks)
+	d.Subscribe(EventWebhookDelivery, "send", s.handleWebhookDelivery)
+}
+
+// handleOrgCreated opens the balance of the new org.
+func (s *Service) handleOrgCreated(ctx context.Context, event *outbox.Event) error {
+	var e OrgCreatedEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	if err := s.m.InitOrgBalance(ctx, e.OrgID); err != nil {
+		return errors.Wrap(err, "failed to init org balance")
+	}
+	return nil
+}
+
+// handleUserRegistered notifies the group of the robot about the new user.
+func (s *Service) handleUserRegistered(ctx context.Context, event *outbox.Event) error {
+	var e UserRegisteredEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	if err := s.dingtalk.SendRobotMessage(ctx, fmt.Sprintf("新用户注册：%s（%s）", e.Username, e.Phone), nil); err != nil {
+		return errors.Wrap(err, "failed to send robot message")
+	}
+	return nil
+}
+
+func (s *Service) handlePasswordChanged(ctx context.Context, event *outbox.Event) error {
+	var e PasswordChangedEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	log.Infof("password of %s changed", e.Phone)
+	return nil
+}
+
+func (s *Service) handleUserRegisteredWebhooks(ctx context.Context, event *outbox.Event) error {
+	var e UserRegisteredEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	return s.enqueueWebhooks(ctx, event.ID, e.OrgID, WebhookEventMemberJoined, MemberJoinedWebhook{
+		UserID:   e.UserID,
+		OrgID:    e.OrgID,
+		Username: e.Username,
+	})
+}
+
+func (s *Service) handleBalanceChangedWebhooks(ctx context.Context, event *outbox.Event) error {
+	var e BalanceChangedEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	return s.enqueueWebhooks(ctx, event.ID, e.OrgID, WebhookEventBalanceChanged, e)
+}
+
+// handleWebhookDelivery sends the delivery, it is retried by the dispatcher with backoff
+// until the endpoint accepts it or the attempts run out.
+func (s *Service) handleWebhookDelivery(ctx context.Context, event *outbox.Event) error {
+	var e WebhookDeliveryEvent
+	if err := event.Decode(&e); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+	delivery, err := s.m.GetWebhookDelivery(ctx, e.DeliveryID)
+	if err != nil {
+		// the webhook is deleted along with its deliveries
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get webhook delivery")
+	}
+	if delivery.Status == WebhookDeliveryStatusSucceeded {
+		return nil
+	}
+	endpoint, err := s.m.GetWebhookEndpointByID(ctx, delivery.EndpointID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get webhook")
+	}
+	if !endpoint.Enabled {
+		log.Infof("dropping delivery %s of disabled webhook %s", delivery.ID, endpoint.ID)
+		return nil
+	}
+	_, err = s.sendWebhook(ctx, endpoint, delivery)
+	return err
+}
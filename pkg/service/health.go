@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/health"
+)
+
+// RegisterHealthChecks registers the readiness checks of the dependencies of the
+// service.
+func (s *Service) RegisterHealthChecks(r *health.Registry) {
+	r.Register("postgres", s.m.Ping)
+	r.Register("migrations", s.checkMigrations)
+	r.Register("sms", s.smsManager.Check)
+}
+
+// checkMigrations fails if the database is behind the migrations of the binary, e.g.
+// the migrations are run by the migrate command and it has not finished yet.
+func (s *Service) checkMigrations(ctx context.Context) error {
+	status, err := s.m.GetMigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if status.Dirty {
+		return errors.Errorf("migration %d failed halfway", status.Version)
+	}
+	if status.Version < status.Latest {
+		return errors.Errorf("%d pending migrations, at version %d of %d", status.Latest-status.Version, status.Version, status.Latest)
+	}
+	return nil
+}
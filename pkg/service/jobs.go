@@ -0,0 +1,10 @@
+package service
+
+import (
+	"github.com/xich-dev/go-starter/pkg/jobs"
+)
+
+// RegisterJobHandlers registers the handlers of the background jobs to the worker.
+func (s *Service) RegisterJobHandlers(w *jobs.Worker) {
+	jobs.Register(w, s.expireRechargeOrder)
+}
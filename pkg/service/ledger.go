@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+)
+
+const (
+	TradeTypeRecharge TradeType = "recharge"
+	TradeTypeConsume  TradeType = "consume"
+	TradeTypeRefund   TradeType = "refund"
+)
+
+// accounts on the other side of the org wallet in the double-entry ledger.
+const (
+	ledgerAccountOrg     = "org"
+	ledgerAccountPayment = "platform:payment"
+	ledgerAccountRevenue = "platform:revenue"
+)
+
+func counterAccount(typ TradeType) string {
+	switch typ {
+	case TradeTypeRecharge:
+		return ledgerAccountPayment
+	default:
+		return ledgerAccountRevenue
+	}
+}
+
+// postLedgerTransaction appends a ledger transaction moving delta cents into (or out of,
+// if negative) the org wallet, and updates the cached balance. The balance row is locked
+// until the database transaction ends, called with a model in a transaction, it is posted
+// in a savepoint of the transaction. The ledger transaction and the new balance are returned.
+func postLedgerTransaction(ctx context.Context, m model.ModelInterface, orgID uuid.UUID, delta int64, typ TradeType, description string) (*querier.LedgerTransaction, int64, error) {
+	var (
+		rtnTxn     *querier.LedgerTransaction
+		rtnBalance int64
+	)
+	if err := m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		if err := model.InitOrgBalance(ctx, orgID); err != nil {
+			return errors.Wrap(err, "failed to init org balance")
+		}
+		balance, err := model.GetOrgBalanceForUpdate(ctx, orgID)
+		if err != nil {
+			return errors.Wrap(err, "failed to lock org balance")
+		}
+		balance += delta
+		if balance < 0 {
+			return ErrInsufficientBalance
+		}
+
+		amount := delta
+		if amount < 0 {
+			amount = -amount
+		}
+		txn, err := model.CreateLedgerTransaction(ctx, querier.CreateLedgerTransactionParams{
+			OrgID:       orgID,
+			Typ:         string(typ),
+			Amount:      amount,
+			Description: description,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create ledger transaction")
+		}
+		if err := model.CreateLedgerEntry(ctx, querier.CreateLedgerEntryParams{
+			TransactionID: txn.ID,
+			Account:       ledgerAccountOrg,
+			OrgID:         uuid.NullUUID{Valid: true, UUID: orgID},
+			Amount:        delta,
+		}); err != nil {
+			return errors.Wrap(err, "failed to create org ledger entry")
+		}
+		if err := model.CreateLedgerEntry(ctx, querier.CreateLedgerEntryParams{
+			TransactionID: txn.ID,
+			Account:       counterAccount(typ),
+			Amount:        -delta,
+		}); err != nil {
+			return errors.Wrap(err, "failed to create counter ledger entry")
+		}
+
+		if err := model.UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+			OrgID:   orgID,
+			Balance: balance,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update org balance")
+		}
+		if err := outbox.Publish(ctx, model, EventBalanceChanged, BalanceChangedEvent{
+			OrgID:         orgID,
+			TransactionID: txn.ID,
+			Type:          typ,
+			Amount:        delta,
+			Balance:       balance,
+		}); err != nil {
+			return err
+		}
+		rtnTxn, rtnBalance = txn, balance
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	return rtnTxn, rtnBalance, nil
+}
+
+func getOrgBalance(ctx context.Context, m model.ModelInterface, orgID uuid.UUID) (int64, error) {
+	balance, err := m.GetOrgBalance(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to get org balance")
+	}
+	return balance, nil
+}
+
+// Credit adds amount cents to the org balance and returns the new balance.
+func (s *Service) Credit(ctx context.Context, orgID uuid.UUID, amount int64, typ TradeType, description string) (int64, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidParams
+	}
+	_, balance, err := postLedgerTransaction(ctx, s.m, orgID, amount, typ, description)
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+// Debit subtracts amount cents from the org balance and returns the new balance,
+// ErrInsufficientBalance is returned if the balance is not enough.
+func (s *Service) Debit(ctx context.Context, orgID uuid.UUID, amount int64, typ TradeType, description string) (int64, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidParams
+	}
+	_, balance, err := postLedgerTransaction(ctx, s.m, orgID, -amount, typ, description)
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
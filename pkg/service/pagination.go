@@ -0,0 +1,51 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// PageParams selects a page of a list in the (created_at, id) keyset order.
+type PageParams struct {
+	Limit      int32
+	Cursor     *utils.Cursor
+	Descending bool
+}
+
+// cursorArgs returns the keyset arguments of the sqlc list queries.
+func (p PageParams) cursorArgs() (*time.Time, uuid.NullUUID) {
+	if p.Cursor == nil {
+		return nil, uuid.NullUUID{}
+	}
+	return &p.Cursor.CreatedAt, uuid.NullUUID{Valid: true, UUID: p.Cursor.ID}
+}
+
+// paginate trims the items, which are queried with one more row than the limit, to the
+// page and returns the cursor of the next page, nil is returned if it is the last page.
+func paginate[T any](items []T, limit int32, cursorOf func(T) utils.Cursor) ([]T, *string) {
+	if int32(len(items)) <= limit {
+		return items, nil
+	}
+	items = items[:limit]
+	next := cursorOf(items[len(items)-1]).Encode()
+	return items, &next
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the wildcards of LIKE in the keyword of a filter.
+func escapeLike(keyword *string) *string {
+	if keyword == nil {
+		return nil
+	}
+	escaped := likeEscaper.Replace(*keyword)
+	return &escaped
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+const TradeTypeSubscription TradeType = "subscription"
+
+type Plan struct {
+	Name string
+	// price of each period in cents
+	Price int64
+	// quota of each usage metric in a period, 0 means unlimited,
+	// quotas of the free plan come from the config
+	Quotas map[string]int64
+	// access rules unlocked by the plan
+	Rules []string
+}
+
+var AllPlans = []*Plan{}
+
+func newPlan(plan *Plan) *Plan {
+	AllPlans = append(AllPlans, plan)
+	return plan
+}
+
+var (
+	PlanFree = newPlan(&Plan{
+		Name: "free",
+	})
+	PlanPro = newPlan(&Plan{
+		Name:  "pro",
+		Price: 9900,
+		Quotas: map[string]int64{
+			UsageMetricSms:     1000,
+			UsageMetricApiCall: 0,
+		},
+		Rules: []string{model.RulePremium},
+	})
+)
+
+func getPlan(name string) *Plan {
+	for _, plan := range AllPlans {
+		if plan.Name == name {
+			return plan
+		}
+	}
+	return nil
+}
+
+// nextPeriod returns the period following the one ending at end, periods lapsed
+// before now are skipped.
+func nextPeriod(end time.Time, now time.Time) (time.Time, time.Time) {
+	start := end
+	if start.AddDate(0, 1, 0).Before(now) {
+		start = now
+	}
+	return start, start.AddDate(0, 1, 0)
+}
+
+func (s *Service) planQuota(plan *Plan, metric string) int64 {
+	if plan == PlanFree {
+		return s.quotas[metric]
+	}
+	return plan.Quotas[metric]
+}
+
+func (s *Service) planToApi(plan *Plan) apigen.Plan {
+	rtn := apigen.Plan{
+		Name:      plan.Name,
+		Price:     plan.Price,
+		PriceText: CentsToCoins(plan.Price),
+		Quotas:    make([]apigen.PlanQuota, 0, len(AllUsageMetrics)),
+		Rules:     make([]string, 0, len(plan.Rules)),
+	}
+	for _, metric := range AllUsageMetrics {
+		rtn.Quotas = append(rtn.Quotas, apigen.PlanQuota{
+			Metric: metric,
+			Limit:  s.planQuota(plan, metric),
+		})
+	}
+	rtn.Rules = append(rtn.Rules, plan.Rules...)
+	return rtn
+}
+
+func (s *Service) ListPlans(ctx context.Context) ([]apigen.Plan, error) {
+	rtn := make([]apigen.Plan, 0, len(AllPlans))
+	for _, plan := range AllPlans {
+		rtn = append(rtn, s.planToApi(plan))
+	}
+	return rtn, nil
+}
+
+// getOrgPlan returns the plan of the org and the current billing period, orgs without
+// a subscription are on the free plan and billed by natural month.
+func (s *Service) getOrgPlan(ctx context.Context, orgID uuid.UUID) (*Plan, time.Time, time.Time, error) {
+	sub, err := s.m.GetOrgSubscription(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			start, end := currentPeriod(s.now())
+			return PlanFree, start, end, nil
+		}
+		return nil, time.Time{}, time.Time{}, errors.Wrap(err, "failed to get org subscription")
+	}
+	plan := getPlan(sub.Plan)
+	if plan == nil {
+		return nil, time.Time{}, time.Time{}, errors.Errorf("unknown plan %s of org %s", sub.Plan, orgID)
+	}
+	return plan, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, nil
+}
+
+func subscriptionToApi(sub *querier.OrgSubscription) apigen.Subscription {
+	return apigen.Subscription{
+		Plan:               sub.Plan,
+		CurrentPeriodStart: sub.CurrentPeriodStart,
+		CurrentPeriodEnd:   sub.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  sub.CancelAtPeriodEnd,
+		Version:            sub.Version,
+	}
+}
+
+func (s *Service) GetOrgSubscription(ctx context.Context, orgID uuid.UUID) (*apigen.Subscription, error) {
+	sub, err := s.m.GetOrgSubscription(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			start, end := currentPeriod(s.now())
+			return &apigen.Subscription{
+				Plan:               PlanFree.Name,
+				CurrentPeriodStart: start,
+				CurrentPeriodEnd:   end,
+			}, nil
+		}
+		return nil, errors.Wrap(err, "failed to get org subscription")
+	}
+	rtn := subscriptionToApi(sub)
+	return &rtn, nil
+}
+
+// proratedRefund returns the price of the part of the current period of the
+// subscription left at now, it is refunded when the org switches to another plan.
+func proratedRefund(sub *querier.OrgSubscription, now time.Time) int64 {
+	plan := getPlan(sub.Plan)
+	if plan == nil || !now.Before(sub.CurrentPeriodEnd) {
+		return 0
+	}
+	total := int64(sub.CurrentPeriodEnd.Sub(sub.CurrentPeriodStart) / time.Second)
+	left := int64(sub.CurrentPeriodEnd.Sub(now) / time.Second)
+	if total <= 0 || left <= 0 {
+		return 0
+	}
+	if left > total {
+		left = total
+	}
+	return plan.Price * left / total
+}
+
+// getOrgSubscriptionForUpdate locks the subscription of the org, nil is returned if
+// the org is on the free plan. ErrVersionConflict is returned if the version is given
+// and does not match, version 0 stands for the free plan.
+func getOrgSubscriptionForUpdate(ctx context.Context, m model.ModelInterface, orgID uuid.UUID, version *int32) (*querier.OrgSubscription, error) {
+	sub, err := m.GetOrgSubscriptionForUpdate(ctx, orgID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(err, "failed to get org subscription")
+		}
+		sub = nil
+	}
+	if version != nil {
+		var current int32
+		if sub != nil {
+			current = sub.Version
+		}
+		if current != *version {
+			return nil, ErrVersionConflict
+		}
+	}
+	return sub, nil
+}
+
+// Subscribe subscribes the org to the plan and debits the price of the first period.
+// Switching from another paid plan refunds the part of its period left, then starts a
+// new period of the plan, subscribing to the free plan refunds it as well and cancels
+// the subscription immediately. Subscribing to the current plan which is canceled at
+// the period end resumes it without charging again. ErrVersionConflict is returned if
+// the version is given and the subscription has been modified since.
+func (s *Service) Subscribe(ctx context.Context, orgID uuid.UUID, planName string, version *int32) (*apigen.Subscription, error) {
+	plan := getPlan(planName)
+	if plan == nil {
+		return nil, ErrPlanNotFound
+	}
+	if plan == PlanFree {
+		if err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+			sub, err := getOrgSubscriptionForUpdate(ctx, model, orgID, version)
+			if err != nil {
+				return err
+			}
+			if sub != nil {
+				if refund := proratedRefund(sub, s.now()); refund > 0 {
+					if _, _, err := postLedgerTransaction(ctx, model, orgID, refund, TradeTypeRefund, fmt.Sprintf("套餐 %s 未使用部分退款", sub.Plan)); err != nil {
+						return err
+					}
+				}
+			}
+			if err := model.DeleteOrgSubscription(ctx, orgID); err != nil {
+				return errors.Wrap(err, "failed to delete org subscription")
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return s.GetOrgSubscription(model.WithPrimary(ctx), orgID)
+	}
+
+	var rtn apigen.Subscription
+	if err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		now := s.now()
+		sub, err := getOrgSubscriptionForUpdate(ctx, model, orgID, version)
+		if err != nil {
+			return err
+		}
+		if sub != nil && sub.Plan == plan.Name && now.Before(sub.CurrentPeriodEnd) {
+			if !sub.CancelAtPeriodEnd {
+				return ErrAlreadySubscribed
+			}
+			sub, err = model.ResumeOrgSubscription(ctx, orgID)
+			if err != nil {
+				return errors.Wrap(err, "failed to resume org subscription")
+			}
+			rtn = subscriptionToApi(sub)
+			return nil
+		}
+
+		if sub != nil {
+			if refund := proratedRefund(sub, now); refund > 0 {
+				if _, _, err := postLedgerTransaction(ctx, model, orgID, refund, TradeTypeRefund, fmt.Sprintf("套餐 %s 未使用部分退款", sub.Plan)); err != nil {
+					return err
+				}
+			}
+		}
+		if _, _, err := postLedgerTransaction(ctx, model, orgID, -plan.Price, TradeTypeSubscription, fmt.Sprintf("订阅套餐 %s", plan.Name)); err != nil {
+			return err
+		}
+		sub, err = model.UpsertOrgSubscription(ctx, querier.UpsertOrgSubscriptionParams{
+			OrgID:              orgID,
+			Plan:               plan.Name,
+			CurrentPeriodStart: now,
+			CurrentPeriodEnd:   now.AddDate(0, 1, 0),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert org subscription")
+		}
+		rtn = subscriptionToApi(sub)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &rtn, nil
+}
+
+// CancelSubscription downgrades the org to the free plan at the end of the current period,
+// ErrVersionConflict is returned if the version is given and the subscription has been
+// modified since. Version 0 stands for the free plan, which has nothing to cancel.
+func (s *Service) CancelSubscription(ctx context.Context, orgID uuid.UUID, version *int32) error {
+	canceled, err := s.m.CancelOrgSubscription(ctx, querier.CancelOrgSubscriptionParams{
+		OrgID:   orgID,
+		Version: version,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to cancel org subscription")
+	}
+	if canceled == 0 && version != nil && *version != 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// RunBilling renews all subscriptions whose current period has ended. The price of the
+// next period is debited from the org balance, orgs with insufficient balance or
+// canceled subscriptions are downgraded to the free plan. A subscription failing to be
+// billed is skipped until the next run, so it does not block billing the others.
+func (s *Service) RunBilling(ctx context.Context) error {
+	ctx = model.WithBypassRLS(ctx)
+	var failed []uuid.UUID
+	for {
+		orgID, err := s.billNextSubscription(ctx, failed)
+		if err != nil {
+			if orgID == nil {
+				return err
+			}
+			log.Errorf("failed to bill subscription of org %s: %v", *orgID, err)
+			failed = append(failed, *orgID)
+			continue
+		}
+		if orgID == nil {
+			break
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("failed to bill %d subscriptions", len(failed))
+	}
+	return nil
+}
+
+// billNextSubscription bills one due subscription of the orgs not excluded, the org is
+// returned along with the error of billing it, nil is returned if there is none due.
+func (s *Service) billNextSubscription(ctx context.Context, excluded []uuid.UUID) (*uuid.UUID, error) {
+	var orgID *uuid.UUID
+	err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		now := s.now()
+		sub, err := model.GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+			CurrentPeriodEnd: now,
+			Excluded:         excluded,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to get due subscription")
+		}
+		orgID = &sub.OrgID
+
+		plan := getPlan(sub.Plan)
+		if sub.CancelAtPeriodEnd || plan == nil || plan == PlanFree {
+			return downgrade(ctx, model, sub.OrgID)
+		}
+		if _, _, err := postLedgerTransaction(ctx, model, sub.OrgID, -plan.Price, TradeTypeSubscription, fmt.Sprintf("续费套餐 %s", plan.Name)); err != nil {
+			if errors.Is(err, ErrInsufficientBalance) {
+				return downgrade(ctx, model, sub.OrgID)
+			}
+			return err
+		}
+		start, end := nextPeriod(sub.CurrentPeriodEnd, now)
+		if err := model.RenewOrgSubscription(ctx, querier.RenewOrgSubscriptionParams{
+			OrgID:              sub.OrgID,
+			CurrentPeriodStart: start,
+			CurrentPeriodEnd:   end,
+		}); err != nil {
+			return errors.Wrap(err, "failed to renew org subscription")
+		}
+		return nil
+	})
+	return orgID, err
+}
+
+func downgrade(ctx context.Context, m model.ModelInterface, orgID uuid.UUID) error {
+	if err := m.DeleteOrgSubscription(ctx, orgID); err != nil {
+		return errors.Wrapf(err, "failed to downgrade org %s", orgID)
+	}
+	return nil
+}
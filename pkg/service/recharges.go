@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
+	"github.com/xich-dev/go-starter/pkg/jobs"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+const (
+	TradeStatusPending TradeStatus = "pending"
+	TradeStatusPaid    TradeStatus = "paid"
+	TradeStatusFailed  TradeStatus = "failed"
+	TradeStatusExpired TradeStatus = "expired"
+)
+
+const RechargeOrderExpireDuration = 15 * time.Minute
+
+func rechargeOrderToApi(order *querier.RechargeOrder) apigen.RechargeOrder {
+	return apigen.RechargeOrder{
+		Id:        order.ID,
+		OrgId:     order.OrgID,
+		Amount:    order.Amount,
+		Status:    order.Status,
+		Provider:  order.Provider,
+		CreatedAt: order.CreatedAt,
+		ExpiredAt: order.ExpiredAt,
+		PaidAt:    order.PaidAt,
+	}
+}
+
+// CreateRechargeOrder creates a pending recharge order and places it to the payment provider.
+func (s *Service) CreateRechargeOrder(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, amount int64) (*apigen.RechargeOrder, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidParams
+	}
+	if s.payment.Name() == payment.ProviderNone {
+		return nil, ErrPaymentProviderNotFound
+	}
+	var order *querier.RechargeOrder
+	if err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		var err error
+		order, err = model.CreateRechargeOrder(ctx, querier.CreateRechargeOrderParams{
+			OrgID:     orgID,
+			UserID:    uuid.NullUUID{Valid: true, UUID: userID},
+			Provider:  s.payment.Name(),
+			Amount:    amount,
+			ExpiredAt: s.now().Add(RechargeOrderExpireDuration),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create recharge order")
+		}
+		// close the order once it expires, in case it is never queried again
+		if _, err := jobs.Enqueue(ctx, model, ExpireRechargeOrderArgs{OrderID: order.ID},
+			jobs.RunAt(order.ExpiredAt),
+			jobs.UniqueKey(order.ID.String()),
+		); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	prepay, err := s.payment.CreateOrder(ctx, payment.Order{
+		ID:          order.ID.String(),
+		Amount:      order.Amount,
+		Description: fmt.Sprintf("账户充值 %s 元", CentsToCoins(order.Amount)),
+		ExpiredAt:   order.ExpiredAt,
+	})
+	if err != nil {
+		if err := s.m.UpdatePendingRechargeOrderStatus(ctx, querier.UpdatePendingRechargeOrderStatusParams{
+			ID:     order.ID,
+			Status: string(TradeStatusFailed),
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to mark recharge order failed")
+		}
+		return nil, errors.Wrap(err, "failed to create order in payment provider")
+	}
+
+	rtn := rechargeOrderToApi(order)
+	rtn.CodeUrl = &prepay.CodeURL
+	return &rtn, nil
+}
+
+// ExpireRechargeOrderArgs marks the recharge order expired if it is still pending.
+type ExpireRechargeOrderArgs struct {
+	OrderID uuid.UUID `json:"orderId"`
+}
+
+func (ExpireRechargeOrderArgs) Kind() string {
+	return "recharge_order.expire"
+}
+
+func (s *Service) expireRechargeOrder(ctx context.Context, args ExpireRechargeOrderArgs) error {
+	if err := s.m.UpdatePendingRechargeOrderStatus(ctx, querier.UpdatePendingRechargeOrderStatusParams{
+		ID:     args.OrderID,
+		Status: string(TradeStatusExpired),
+	}); err != nil {
+		return errors.Wrap(err, "failed to mark recharge order expired")
+	}
+	return nil
+}
+
+// GetRechargeOrder returns the recharge order, pending orders past their expiry are marked expired.
+func (s *Service) GetRechargeOrder(ctx context.Context, orgID uuid.UUID, orderID uuid.UUID) (*apigen.RechargeOrder, error) {
+	order, err := s.m.GetRechargeOrder(ctx, querier.GetRechargeOrderParams{
+		ID:    orderID,
+		OrgID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRechargeOrderNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get recharge order")
+	}
+	if order.Status == string(TradeStatusPending) && order.ExpiredAt.Before(s.now()) {
+		if err := s.m.UpdatePendingRechargeOrderStatus(ctx, querier.UpdatePendingRechargeOrderStatusParams{
+			ID:     order.ID,
+			Status: string(TradeStatusExpired),
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to mark recharge order expired")
+		}
+		order.Status = string(TradeStatusExpired)
+	}
+	rtn := rechargeOrderToApi(order)
+	return &rtn, nil
+}
+
+// HandlePaymentNotification verifies the notification from the payment provider and credits
+// the org balance. Providers retry notifications, so an order is only credited once.
+func (s *Service) HandlePaymentNotification(ctx context.Context, provider string, header http.Header, body []byte) error {
+	if provider != s.payment.Name() || provider == payment.ProviderNone {
+		return ErrPaymentProviderNotFound
+	}
+	n, err := s.payment.ParseNotification(header, body)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			return ErrInvalidPaymentNotification
+		}
+		return errors.Wrap(err, "failed to parse payment notification")
+	}
+	// the notification is not sent on behalf of an org, the order tells the org
+	ctx = model.WithBypassRLS(ctx)
+	orderID, err := uuid.Parse(n.OrderID)
+	if err != nil {
+		return ErrRechargeOrderNotFound
+	}
+
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		order, err := model.GetRechargeOrderForUpdate(ctx, orderID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrRechargeOrderNotFound
+			}
+			return errors.Wrap(err, "failed to get recharge order")
+		}
+		if order.Status == string(TradeStatusPaid) {
+			return nil
+		}
+		if !n.Paid {
+			if err := model.UpdatePendingRechargeOrderStatus(ctx, querier.UpdatePendingRechargeOrderStatusParams{
+				ID:     order.ID,
+				Status: string(TradeStatusFailed),
+			}); err != nil {
+				return errors.Wrap(err, "failed to mark recharge order failed")
+			}
+			return nil
+		}
+		if n.Amount != order.Amount {
+			return errors.Wrapf(ErrInvalidPaymentNotification, "amount of recharge order %s mismatch, expected %d, got %d", order.ID, order.Amount, n.Amount)
+		}
+
+		// the money is received even if the order is expired, so it is always credited
+		txn, _, err := postLedgerTransaction(ctx, model, order.OrgID, order.Amount, TradeTypeRecharge, fmt.Sprintf("充值订单 %s", order.ID))
+		if err != nil {
+			return err
+		}
+		if err := model.MarkRechargeOrderPaid(ctx, querier.MarkRechargeOrderPaidParams{
+			ID:                  order.ID,
+			ProviderTradeID:     &n.TradeID,
+			LedgerTransactionID: uuid.NullUUID{Valid: true, UUID: txn.ID},
+		}); err != nil {
+			return errors.Wrap(err, "failed to mark recharge order paid")
+		}
+		return nil
+	})
+}
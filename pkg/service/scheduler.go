@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+// Built-in scheduled tasks, their schedules are set in the scheduler section of the
+// config.
+const (
+	TaskBilling         = "billing"
+	TaskPurgeAccounts   = "purgeaccounts"
+	TaskPurgePhoneCodes = "purgephonecodes"
+)
+
+// RegisterScheduledTasks registers the built-in tasks to the scheduler.
+func (s *Service) RegisterScheduledTasks(sc *scheduler.Scheduler) {
+	sc.Register(TaskBilling, s.RunBilling)
+	sc.Register(TaskPurgeAccounts, s.PurgeDeletedAccounts)
+	sc.Register(TaskPurgePhoneCodes, s.PurgeExpiredPhoneCodes)
+}
+
+// PurgeExpiredPhoneCodes deletes the expired verification codes, a code is sent again
+// with a new row.
+func (s *Service) PurgeExpiredPhoneCodes(ctx context.Context) error {
+	purged, err := s.m.PurgeExpiredPhoneCodes(ctx, s.now())
+	if err != nil {
+		return errors.Wrap(err, "failed to purge expired phone codes")
+	}
+	if purged > 0 {
+		log.Infof("purged %d expired phone codes", purged)
+	}
+	return nil
+}
+
+func (s *Service) ListScheduledTaskRuns(ctx context.Context, task *string, page PageParams) (*apigen.ScheduledTaskRunPage, error) {
+	cursorCreatedAt, cursorID := page.cursorArgs()
+	runs, err := s.m.ListScheduledTaskRuns(ctx, querier.ListScheduledTaskRunsParams{
+		Task:            task,
+		CursorCreatedAt: cursorCreatedAt,
+		Descending:      page.Descending,
+		CursorID:        cursorID,
+		RowLimit:        page.Limit + 1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list scheduled task runs")
+	}
+	runs, next := paginate(runs, page.Limit, func(r *querier.ScheduledTaskRun) utils.Cursor {
+		return utils.Cursor{CreatedAt: r.CreatedAt, ID: r.ID}
+	})
+	rtn := &apigen.ScheduledTaskRunPage{
+		Items:      make([]apigen.ScheduledTaskRun, 0, len(runs)),
+		NextCursor: next,
+	}
+	for _, r := range runs {
+		rtn.Items = append(rtn.Items, apigen.ScheduledTaskRun{
+			Id:          r.ID,
+			Task:        r.Task,
+			ScheduledAt: r.ScheduledAt,
+			Status:      r.Status,
+			Error:       r.Error,
+			StartedAt:   r.StartedAt,
+			FinishedAt:  r.FinishedAt,
+		})
+	}
+	return rtn, nil
+}
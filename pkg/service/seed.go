@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/sql/seeds"
+	"gopkg.in/yaml.v3"
+)
+
+// SeedFixtures is the data declared in a fixture set, access rules defined in code are
+// always seeded.
+type SeedFixtures struct {
+	Rules []string   `yaml:"rules" json:"rules"`
+	Roles []SeedRole `yaml:"roles" json:"roles"`
+	Admin *SeedAdmin `yaml:"admin" json:"admin"`
+}
+
+type SeedRole struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description"`
+	// the role has exactly these access rules after seeding
+	Rules []string `yaml:"rules" json:"rules"`
+}
+
+// SeedAdmin is the initial admin user, it is created with its own org if it does not
+// exist, an existing admin only gets the missing roles.
+type SeedAdmin struct {
+	Name  string   `yaml:"name" json:"name"`
+	Org   string   `yaml:"org" json:"org"`
+	Roles []string `yaml:"roles" json:"roles"`
+	// the plan the org is subscribed to without being charged, defaults to free
+	Plan string `yaml:"plan" json:"plan"`
+	// come from the config instead of the fixtures
+	Phone    string `yaml:"-" json:"-"`
+	Password string `yaml:"-" json:"-"`
+}
+
+// LoadSeedFixtures loads the YAML and JSON fixtures of the environment in the order of
+// their file names, env overrides the one in the config if it is not empty.
+func LoadSeedFixtures(cfg *config.Seed, env string) (*SeedFixtures, error) {
+	if len(env) == 0 {
+		env = cfg.Env
+	}
+	if len(env) == 0 {
+		return nil, errors.New("seed env is not set")
+	}
+	var fsys fs.FS = seeds.FS
+	if len(cfg.Dir) > 0 {
+		fsys = os.DirFS(cfg.Dir)
+	}
+	entries, err := fs.ReadDir(fsys, env)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read fixtures of %s", env)
+	}
+
+	var fixtures SeedFixtures
+	for _, entry := range entries {
+		ext := path.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		raw, err := fs.ReadFile(fsys, path.Join(env, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", entry.Name())
+		}
+		// JSON is a subset of YAML
+		var f SeedFixtures
+		if err := yaml.Unmarshal(raw, &f); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", entry.Name())
+		}
+		fixtures.Rules = append(fixtures.Rules, f.Rules...)
+		fixtures.Roles = append(fixtures.Roles, f.Roles...)
+		if f.Admin != nil {
+			fixtures.Admin = f.Admin
+		}
+	}
+	if fixtures.Admin != nil {
+		fixtures.Admin.Phone = cfg.AdminPhone
+		fixtures.Admin.Password = cfg.AdminPassword
+	}
+	return &fixtures, nil
+}
+
+func (f *SeedFixtures) validate() error {
+	rules := append(slices.Clone(model.AllRules), f.Rules...)
+	roles := make([]string, 0, len(f.Roles))
+	for _, role := range f.Roles {
+		for _, rule := range role.Rules {
+			if !slices.Contains(rules, rule) {
+				return errors.Errorf("unknown access rule %s of role %s", rule, role.Name)
+			}
+		}
+		roles = append(roles, role.Name)
+	}
+	if f.Admin != nil {
+		if len(f.Admin.Name) == 0 || len(f.Admin.Org) == 0 {
+			return errors.New("name and org of the admin are required")
+		}
+		if len(f.Admin.Phone) == 0 {
+			return errors.New("seed.adminphone is required to seed the admin")
+		}
+		for _, role := range f.Admin.Roles {
+			if !slices.Contains(roles, role) {
+				return errors.Errorf("unknown role %s of the admin", role)
+			}
+		}
+		if len(f.Admin.Plan) > 0 && getPlan(f.Admin.Plan) == nil {
+			return errors.Errorf("unknown plan %s of the admin", f.Admin.Plan)
+		}
+	}
+	return nil
+}
+
+// Seed loads the fixtures into the database in one transaction, it can be run any
+// number of times.
+func (s *Service) Seed(ctx context.Context, fixtures *SeedFixtures) error {
+	if err := fixtures.validate(); err != nil {
+		return err
+	}
+	ctx = model.WithBypassRLS(ctx)
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		if err := seedRules(ctx, model, fixtures.Rules); err != nil {
+			return err
+		}
+		for _, role := range fixtures.Roles {
+			if err := seedRole(ctx, model, role); err != nil {
+				return err
+			}
+		}
+		if fixtures.Admin != nil {
+			if err := s.seedAdmin(ctx, model, fixtures.Admin); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func seedRules(ctx context.Context, m model.ModelInterface, rules []string) error {
+	for _, rule := range append(slices.Clone(model.AllRules), rules...) {
+		if err := m.CreateAccessRuleIfNotExists(ctx, rule); err != nil {
+			return errors.Wrapf(err, "failed to create access rule %s", rule)
+		}
+	}
+	return nil
+}
+
+func seedRole(ctx context.Context, m model.ModelInterface, role SeedRole) error {
+	r, err := m.UpsertRole(ctx, querier.UpsertRoleParams{
+		Name:        role.Name,
+		Description: role.Description,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert role %s", role.Name)
+	}
+	rules := role.Rules
+	if rules == nil {
+		rules = []string{}
+	}
+	if err := m.RemoveRoleAccessRulesExcept(ctx, querier.RemoveRoleAccessRulesExceptParams{
+		RoleID: r.ID,
+		Rules:  rules,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to remove access rules of role %s", role.Name)
+	}
+	if err := m.AddRoleAccessRules(ctx, querier.AddRoleAccessRulesParams{
+		RoleID: r.ID,
+		Rules:  rules,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add access rules of role %s", role.Name)
+	}
+	return nil
+}
+
+// seedAdmin creates the admin if it does not exist and grants it the roles. An existing
+// user is only taken as the admin if both its name and phone match the fixture, as
+// GetUser matches either of them and anyone could have registered the name.
+func (s *Service) seedAdmin(ctx context.Context, m model.ModelInterface, admin *SeedAdmin) error {
+	user, err := m.GetUser(ctx, admin.Name)
+	if err == nil && (user.Name != admin.Name || user.Phone != admin.Phone) {
+		return errors.Errorf("user %s exists but does not match the admin fixture, refusing to grant it the admin roles", admin.Name)
+	}
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return errors.Wrap(err, "failed to get admin")
+		}
+		if len(admin.Password) == 0 {
+			return errors.New("seed.adminpassword is required to create the admin")
+		}
+		if user, err = s.createAdmin(ctx, m, admin); err != nil {
+			return err
+		}
+		log.Infof("created admin %s", admin.Name)
+	}
+
+	for _, role := range admin.Roles {
+		if err := m.AddUserRole(ctx, querier.AddUserRoleParams{
+			UserID: user.ID,
+			Role:   role,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add role %s to admin", role)
+		}
+	}
+	return nil
+}
+
+func (s *Service) createAdmin(ctx context.Context, m model.ModelInterface, admin *SeedAdmin) (*querier.User, error) {
+	salt, hashedPassword, err := s.generateHashAndSalt(admin.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate hash and salt")
+	}
+	org, err := m.CreateOrg(ctx, admin.Org)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create org")
+	}
+	user, err := m.CreateUser(ctx, querier.CreateUserParams{
+		OrgID:        org.ID,
+		Name:         admin.Name,
+		Phone:        admin.Phone,
+		PasswordHash: hashedPassword,
+		PasswordSalt: salt,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+	if err := m.UpdateOrgOwnerID(ctx, querier.UpdateOrgOwnerIDParams{
+		OwnerID: uuid.NullUUID{Valid: true, UUID: user.ID},
+		ID:      org.ID,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to update org owner id")
+	}
+
+	if plan := getPlan(admin.Plan); plan != nil && plan != PlanFree {
+		now := s.now()
+		if _, err := m.UpsertOrgSubscription(ctx, querier.UpsertOrgSubscriptionParams{
+			OrgID:              org.ID,
+			Plan:               plan.Name,
+			CurrentPeriodStart: now,
+			CurrentPeriodEnd:   now.AddDate(0, 1, 0),
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to subscribe the admin org")
+		}
+	}
+	return user, nil
+}
@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,13 +13,23 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/cloud/dingtalk"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
 	"github.com/xich-dev/go-starter/pkg/cloud/sms"
 	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
+	"github.com/xich-dev/go-starter/pkg/logger"
 	"github.com/xich-dev/go-starter/pkg/model"
 	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
 	"github.com/xich-dev/go-starter/pkg/utils"
+	"github.com/xich-dev/go-starter/pkg/webhook"
 )
 
+var log = logger.NewLogAgent("service")
+
 type (
 	TradeType   string
 	TradeStatus string
@@ -30,22 +43,60 @@ var (
 	ErrCodeExpire              = errors.New("code expired")
 	ErrCodeInvalid             = errors.New("invalid code")
 	ErrUsernameAlreadyExist    = errors.New("用户名已注册")
+	ErrUsernameReserved        = errors.New("用户名不可用")
 	ErrPhoneAlreadyExist       = errors.New("手机号已注册")
+	ErrPhoneReserved           = errors.New("手机号不可用")
 	ErrUsernameOrPhoneNotFound = errors.New("用户名或手机号不存在")
 	ErrDeletedUser             = errors.New("用户名或手机号不存在")
 	ErrIncorrectPassword       = errors.New("密码错误")
 	ErrInvalidParams           = errors.New("参数错误")
+	ErrVersionConflict         = errors.New("数据已被修改，请刷新后重试")
 
 	//trade
-	ErrInsufficientBalance = errors.New("余额不足，请充值")
+	ErrInsufficientBalance        = errors.New("余额不足，请充值")
+	ErrRechargeOrderNotFound      = errors.New("充值订单不存在")
+	ErrPaymentProviderNotFound    = errors.New("不支持的支付渠道")
+	ErrInvalidPaymentNotification = errors.New("支付回调校验失败")
+
+	//usage
+	ErrQuotaExceeded = errors.New("已超出套餐额度")
+
+	//plan
+	ErrPlanNotFound      = errors.New("套餐不存在")
+	ErrAlreadySubscribed = errors.New("已订阅该套餐")
+
+	//statement
+	ErrInvalidStatementMonth   = errors.New("对账月份格式错误")
+	ErrStatementPeriodNotEnded = errors.New("对账月份尚未结束")
+	ErrStatementBeforeOrg      = errors.New("对账月份早于组织创建时间")
+
+	//dingtalk
+	ErrInvalidDingTalkCallback = errors.New("钉钉回调校验失败")
 
 	//org
-	ErrOrgNotFound = errors.New("组织不存在")
+	ErrOrgNotFound   = errors.New("组织不存在")
+	ErrNotOrgOwner   = errors.New("只有组织拥有者可以执行此操作")
+	ErrUserNotInOrg  = errors.New("用户不属于该组织")
+	ErrOrgHasMembers = errors.New("组织内还有其他成员，请先移除成员")
+
+	//team
+	ErrTeamNotFound       = errors.New("团队不存在")
+	ErrTeamAlreadyExist   = errors.New("团队名称已存在")
+	ErrAccessRuleNotFound = errors.New("访问规则不存在")
+	ErrReservedAccessRule = errors.New("平台访问规则不能授予团队")
+
+	//webhook
+	ErrWebhookNotFound       = errors.New("Webhook不存在")
+	ErrInvalidWebhookURL     = errors.New("Webhook地址须为http或https链接")
+	ErrInvalidWebhookEvent   = errors.New("不支持的Webhook事件")
+	ErrWebhookHostNotAllowed = errors.New("Webhook地址须解析为公网地址")
 )
 
 const (
-	ExpireDuration    = 2 * time.Minute
-	DefaultMaxRetries = 3
+	ExpireDuration = 2 * time.Minute
+	// DefaultMaxRetries is how many times the transactions of the service are retried
+	// on serialization failures and deadlocks
+	DefaultMaxRetries = model.DefaultMaxRetries
 )
 
 type ServiceInterface interface {
@@ -63,6 +114,120 @@ type ServiceInterface interface {
 
 	GetOrgInfoByOrgId(ctx context.Context, ID uuid.UUID) (*apigen.OrgInfoRes, error)
 
+	CheckOrgOwner(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error
+
+	ListOrgMembers(ctx context.Context, orgID uuid.UUID, page PageParams, keyword *string) (*apigen.OrgMemberPage, error)
+
+	// accounts
+
+	DeleteAccount(ctx context.Context, userID uuid.UUID, password string) error
+
+	PurgeDeletedAccounts(ctx context.Context) error
+
+	IsUserActive(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// ledger
+
+	Credit(ctx context.Context, orgID uuid.UUID, amount int64, typ TradeType, description string) (int64, error)
+
+	Debit(ctx context.Context, orgID uuid.UUID, amount int64, typ TradeType, description string) (int64, error)
+
+	// recharges
+
+	CreateRechargeOrder(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, amount int64) (*apigen.RechargeOrder, error)
+
+	GetRechargeOrder(ctx context.Context, orgID uuid.UUID, orderID uuid.UUID) (*apigen.RechargeOrder, error)
+
+	HandlePaymentNotification(ctx context.Context, provider string, header http.Header, body []byte) error
+
+	// usage
+
+	ConsumeQuota(ctx context.Context, orgID uuid.UUID, metric string) (bool, error)
+
+	GetOrgUsage(ctx context.Context, orgID uuid.UUID) (*apigen.OrgUsage, error)
+
+	// plans
+
+	ListPlans(ctx context.Context) ([]apigen.Plan, error)
+
+	GetOrgSubscription(ctx context.Context, orgID uuid.UUID) (*apigen.Subscription, error)
+
+	Subscribe(ctx context.Context, orgID uuid.UUID, planName string, version *int32) (*apigen.Subscription, error)
+
+	CancelSubscription(ctx context.Context, orgID uuid.UUID, version *int32) error
+
+	RunBilling(ctx context.Context) error
+
+	// statements
+
+	ListStatements(ctx context.Context, orgID uuid.UUID) ([]apigen.Statement, error)
+
+	GetStatement(ctx context.Context, orgID uuid.UUID, month string) (*apigen.StatementDetail, error)
+
+	// dingtalk
+
+	HandleDingTalkCallback(ctx context.Context, signature, timestamp, nonce string, body []byte) (*apigen.DingTalkCallbackResponse, error)
+
+	// teams
+
+	ListTeams(ctx context.Context, orgID uuid.UUID) ([]apigen.Team, error)
+
+	CreateTeam(ctx context.Context, orgID uuid.UUID, name string) (*apigen.Team, error)
+
+	GetTeamDetail(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID) (*apigen.TeamDetail, error)
+
+	RenameTeam(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, name string, version *int32) (*apigen.Team, error)
+
+	DeleteTeam(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, version *int32) error
+
+	AddTeamMember(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, userID uuid.UUID) error
+
+	RemoveTeamMember(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, userID uuid.UUID) error
+
+	GrantTeamAccessRule(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, ruleName string) error
+
+	RevokeTeamAccessRule(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, ruleName string) error
+
+	// webhooks
+
+	ListWebhooks(ctx context.Context, orgID uuid.UUID) ([]apigen.Webhook, error)
+
+	CreateWebhook(ctx context.Context, orgID uuid.UUID, url string, events []string) (*apigen.Webhook, error)
+
+	GetWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) (*apigen.Webhook, error)
+
+	UpdateWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID, url string, events []string, enabled bool) (*apigen.Webhook, error)
+
+	DeleteWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) error
+
+	ListWebhookDeliveries(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID, page PageParams) (*apigen.WebhookDeliveryPage, error)
+
+	TestWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) (*apigen.WebhookDelivery, error)
+
+	// seed
+
+	Seed(ctx context.Context, fixtures *SeedFixtures) error
+
+	// events
+
+	RegisterEventHandlers(d *outbox.Dispatcher)
+
+	// jobs
+
+	RegisterJobHandlers(w *jobs.Worker)
+
+	// scheduler
+
+	RegisterScheduledTasks(sc *scheduler.Scheduler)
+
+	ListScheduledTaskRuns(ctx context.Context, task *string, page PageParams) (*apigen.ScheduledTaskRunPage, error)
+
+	PurgeExpiredPhoneCodes(ctx context.Context) error
+
+	// health
+
+	RegisterHealthChecks(r *health.Registry)
+
 	// for Testing
 	AddUserAccessRuleByUsername(ctx context.Context, username string, ruleNames ...string) error
 }
@@ -70,23 +235,41 @@ type ServiceInterface interface {
 type Service struct {
 	m          model.ModelInterface
 	smsManager sms.SMSManagerInterface
+	payment    payment.PaymentProviderInterface
+	dingtalk   dingtalk.DingTalkClientInterface
+	webhook    webhook.SenderInterface
 
 	now                 func() time.Time
 	generateHashAndSalt func(password string) (string, string, error)
+
+	// monthly quota of each usage metric, 0 means unlimited
+	quotas map[string]int64
+
+	// phone of the seeded admin, it cannot be registered
+	adminPhone string
 }
 
-func NewService(cfg *config.Config, m model.ModelInterface, smsManager sms.SMSManagerInterface) ServiceInterface {
+func NewService(cfg *config.Config, m model.ModelInterface, smsManager sms.SMSManagerInterface, payment payment.PaymentProviderInterface, dingtalk dingtalk.DingTalkClientInterface, webhook webhook.SenderInterface) ServiceInterface {
 	return &Service{
 		m:                   m,
 		smsManager:          smsManager,
+		payment:             payment,
+		dingtalk:            dingtalk,
+		webhook:             webhook,
 		now:                 time.Now,
 		generateHashAndSalt: utils.GenerateHashAndSalt,
+		quotas: map[string]int64{
+			UsageMetricSms:     cfg.Quota.Sms,
+			UsageMetricApiCall: cfg.Quota.ApiCall,
+		},
+		adminPhone: cfg.Seed.AdminPhone,
 	}
 }
 
 // CreateCode creates a new code for the phone number.
 // if the code exists and not expired, return ErrNotExpired
 // if the code does not exist or it is expired, create a new code and send it to the phone number
+// if the phone number belongs to a user whose org has used up its SMS quota, return ErrQuotaExceeded
 func (s *Service) CreateCode(ctx context.Context, param apigen.PostAuthCodeJSONBody) error {
 	code, err := s.m.GetPhoneCode(ctx, querier.GetPhoneCodeParams{
 		Phone: param.Phone,
@@ -104,6 +287,27 @@ func (s *Service) CreateCode(ctx context.Context, param apigen.PostAuthCodeJSONB
 		return ErrCodeNotExpired
 	}
 
+	// codes sent to registered users are metered into their orgs, the user is not
+	// authenticated so the usage queries are scoped to the org of the user here. The
+	// quota is consumed before sending, so concurrent requests cannot exceed it and a
+	// send is never repeated for a failed record.
+	if param.Typ == apigen.ChangePassword {
+		user, err := s.m.GetUser(model.WithBypassRLS(ctx), param.Phone)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				return errors.Wrap(err, "failed to get user")
+			}
+		} else {
+			ok, err := s.ConsumeQuota(model.WithOrg(ctx, user.OrgID), user.OrgID, UsageMetricSms)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return ErrQuotaExceeded
+			}
+		}
+	}
+
 	newCode := s.smsManager.GenerateCode()
 
 	_, err = s.m.UpsertPhoneCode(ctx, querier.UpsertPhoneCodeParams{
@@ -124,7 +328,7 @@ func (s *Service) CreateCode(ctx context.Context, param apigen.PostAuthCodeJSONB
 
 func (s *Service) VerifyCode(ctx context.Context, phone string, typ apigen.PostAuthCodeJSONBodyTyp, code string) error {
 	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
-		phoneCode, err := s.m.GetPhoneCode(ctx, querier.GetPhoneCodeParams{
+		phoneCode, err := model.GetPhoneCode(ctx, querier.GetPhoneCodeParams{
 			Phone: phone,
 			Typ:   string(typ),
 		})
@@ -143,7 +347,7 @@ func (s *Service) VerifyCode(ctx context.Context, phone string, typ apigen.PostA
 		if phoneCode.Code != code {
 			return ErrCodeInvalid
 		}
-		if err := s.m.MarkPhoneCodeUsed(ctx, querier.MarkPhoneCodeUsedParams{
+		if err := model.MarkPhoneCodeUsed(ctx, querier.MarkPhoneCodeUsedParams{
 			Phone: phone,
 			Typ:   string(typ),
 		}); err != nil {
@@ -162,14 +366,39 @@ func CentsToCoins[T int | int32 | uint32 | uint64 | int64](cents T) string {
 	return fmt.Sprintf("%s.%d", left, rightNum)
 }
 
+// reservedUsernames cannot be registered, so that nobody can take the name of the admin
+// before it is seeded, neither can the phone of the admin.
+var reservedUsernames = []string{"admin", "administrator", "root", "system"}
+
+func isReservedUsername(name string) bool {
+	return slices.ContainsFunc(reservedUsernames, func(reserved string) bool {
+		return strings.EqualFold(strings.TrimSpace(name), reserved)
+	})
+}
+
 func (s *Service) CreateUserWithNewOrg(ctx context.Context, param apigen.PostAuthRegisterJSONBody) error {
+	if isReservedUsername(param.Username) {
+		return ErrUsernameReserved
+	}
+	if len(s.adminPhone) > 0 && param.Phone == s.adminPhone {
+		return ErrPhoneReserved
+	}
 	salt, hashedPassword, err := s.generateHashAndSalt(param.Password)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate hash and salt")
 	}
 
+	// recently deleted accounts keep their username and phone during the grace period
+	deletedAfter := s.now().Add(-AccountDeletionGracePeriod)
+
+	// the username and the phone are unique across the orgs, and the org of the user
+	// does not exist yet
+	ctx = model.WithBypassRLS(ctx)
 	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
-		exist, err := model.IsUsernameExist(ctx, param.Username)
+		exist, err := model.IsUsernameExist(ctx, querier.IsUsernameExistParams{
+			Name:         param.Username,
+			DeletedAfter: deletedAfter,
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to check username exist")
 		}
@@ -177,7 +406,10 @@ func (s *Service) CreateUserWithNewOrg(ctx context.Context, param apigen.PostAut
 			return ErrUsernameAlreadyExist
 		}
 
-		exist, err = model.IsPhoneExist(ctx, param.Phone)
+		exist, err = model.IsPhoneExist(ctx, querier.IsPhoneExistParams{
+			Phone:        param.Phone,
+			DeletedAfter: deletedAfter,
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to check phone exist")
 		}
@@ -211,12 +443,25 @@ func (s *Service) CreateUserWithNewOrg(ctx context.Context, param apigen.PostAut
 			return errors.Wrap(err, "failed to update org owner id")
 		}
 
-		return nil
+		if err := outbox.Publish(ctx, model, EventOrgCreated, OrgCreatedEvent{
+			OrgID:   org.ID,
+			OwnerID: user.ID,
+			Name:    org.Name,
+		}); err != nil {
+			return err
+		}
+		return outbox.Publish(ctx, model, EventUserRegistered, UserRegisteredEvent{
+			UserID:   user.ID,
+			OrgID:    org.ID,
+			Username: user.Name,
+			Phone:    user.Phone,
+		})
 	})
 }
 
 func (s *Service) VerifyLoginInfo(ctx context.Context, param apigen.PostAuthLoginJSONBody) (*querier.User, []string, error) {
-	user, err := s.m.GetUser(ctx, param.UsernameOrPhone)
+	// the org of the user is not known until the user is found
+	user, err := s.m.GetUser(model.WithBypassRLS(ctx), param.UsernameOrPhone)
 	if err != nil {
 		if !errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil, errors.Wrap(err, "failed to get user")
@@ -226,6 +471,8 @@ func (s *Service) VerifyLoginInfo(ctx context.Context, param apigen.PostAuthLogi
 	if user.DeletedAt != nil {
 		return nil, nil, ErrDeletedUser
 	}
+	// the user is not authenticated yet, scope the queries to the org of the user
+	ctx = model.WithOrg(ctx, user.OrgID)
 	inputHashPassword, err := utils.HashPassword(param.Password, user.PasswordSalt)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to hash password")
@@ -237,6 +484,16 @@ func (s *Service) VerifyLoginInfo(ctx context.Context, param apigen.PostAuthLogi
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to get user access rules")
 	}
+	// rules unlocked by the plan of the org
+	plan, _, _, err := s.getOrgPlan(ctx, user.OrgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, rule := range plan.Rules {
+		if !slices.Contains(rules, rule) {
+			rules = append(rules, rule)
+		}
+	}
 	return user, rules, nil
 }
 
@@ -245,17 +502,24 @@ func (s *Service) ChangePassword(ctx context.Context, param apigen.PostAuthChang
 	if err != nil {
 		return errors.Wrap(err, "failed to generate hash and salt")
 	}
-	if err := s.m.UpdateUserPasswordByPhone(ctx, querier.UpdateUserPasswordByPhoneParams{
-		Phone:        param.Phone,
-		PasswordHash: hashedPassword,
-		PasswordSalt: salt,
-	}); err != nil {
-		return errors.Wrap(err, "failed to reset password")
-	}
-	return nil
+	// the user is not authenticated, the phone has been verified by the code instead
+	ctx = model.WithBypassRLS(ctx)
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		if err := model.UpdateUserPasswordByPhone(ctx, querier.UpdateUserPasswordByPhoneParams{
+			Phone:        param.Phone,
+			PasswordHash: hashedPassword,
+			PasswordSalt: salt,
+		}); err != nil {
+			return errors.Wrap(err, "failed to reset password")
+		}
+		return outbox.Publish(ctx, model, EventPasswordChanged, PasswordChangedEvent{
+			Phone: param.Phone,
+		})
+	})
 }
 
 func (s *Service) AddUserAccessRuleByUsername(ctx context.Context, username string, ruleNames ...string) error {
+	ctx = model.WithBypassRLS(ctx)
 	for _, ruleName := range ruleNames {
 		r, err := s.m.GetAccessRule(ctx, ruleName)
 		if err != nil {
@@ -291,9 +555,63 @@ func (s *Service) GetOrgInfoByOrgId(ctx context.Context, ID uuid.UUID) (*apigen.
 		ownerId = &orgInfo.OwnerID.UUID
 	}
 
+	balance, err := getOrgBalance(ctx, s.m, ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apigen.OrgInfoRes{
 		Id:      orgInfo.ID,
 		Name:    orgInfo.Name,
 		OwnerId: ownerId,
+		Balance: balance,
 	}, nil
 }
+
+// CheckOrgOwner returns ErrNotOrgOwner if the user is not the owner of the org.
+func (s *Service) CheckOrgOwner(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error {
+	org, err := s.m.GetOrgInfoByOrgId(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrOrgNotFound
+		}
+		return errors.Wrap(err, "failed to get org info by org id")
+	}
+	if !org.OwnerID.Valid || org.OwnerID.UUID != userID {
+		return ErrNotOrgOwner
+	}
+	return nil
+}
+
+// ListOrgMembers returns a page of the members of the org, the keyword filters the
+// members by username or phone.
+func (s *Service) ListOrgMembers(ctx context.Context, orgID uuid.UUID, page PageParams, keyword *string) (*apigen.OrgMemberPage, error) {
+	cursorCreatedAt, cursorID := page.cursorArgs()
+	members, err := s.m.ListOrgMembers(ctx, querier.ListOrgMembersParams{
+		OrgID:           orgID,
+		Keyword:         escapeLike(keyword),
+		CursorCreatedAt: cursorCreatedAt,
+		Descending:      page.Descending,
+		CursorID:        cursorID,
+		RowLimit:        page.Limit + 1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list org members")
+	}
+	members, next := paginate(members, page.Limit, func(m *querier.ListOrgMembersRow) utils.Cursor {
+		return utils.Cursor{CreatedAt: m.CreatedAt, ID: m.ID}
+	})
+	rtn := &apigen.OrgMemberPage{
+		Items:      make([]apigen.OrgMember, 0, len(members)),
+		NextCursor: next,
+	}
+	for _, m := range members {
+		rtn.Items = append(rtn.Items, apigen.OrgMember{
+			Id:        m.ID,
+			Username:  m.Name,
+			Phone:     m.Phone,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return rtn, nil
+}
@@ -3,19 +3,33 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/cloud/dingtalk"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
 	"github.com/xich-dev/go-starter/pkg/cloud/sms"
+	"github.com/xich-dev/go-starter/pkg/config"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
 	"github.com/xich-dev/go-starter/pkg/model"
 	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
 	"github.com/xich-dev/go-starter/pkg/utils"
+	"github.com/xich-dev/go-starter/pkg/webhook"
 )
 
 func TestCreateCode_exist_no_expire(t *testing.T) {
@@ -213,6 +227,103 @@ func TestCreateCode_not_exist(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateCode_change_password(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = context.Background()
+		phone   = "18088805143"
+		code    = "9527"
+		typ     = string(apigen.ChangePassword)
+		orgID   = uuid.Must(uuid.NewRandom())
+		nowTime = time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+		// the user is not authenticated, the usage queries are scoped to its org
+		orgCtx = model.WithOrg(ctx, orgID)
+	)
+
+	for _, consumed := range []int64{1, 0} {
+		var (
+			mockModel = model.NewMockModelInterface(ctrl)
+			mockSMS   = sms.NewMockSMSManagerInterface(ctrl)
+		)
+
+		mockModel.
+			EXPECT().
+			GetPhoneCode(ctx, querier.GetPhoneCodeParams{
+				Phone: phone,
+				Typ:   typ,
+			}).
+			Return(nil, pgx.ErrNoRows)
+		mockModel.
+			EXPECT().
+			GetUser(model.WithBypassRLS(ctx), phone).
+			Return(&querier.User{ID: uuid.Must(uuid.NewRandom()), OrgID: orgID, Phone: phone}, nil)
+		mockModel.
+			EXPECT().
+			GetOrgSubscription(orgCtx, orgID).
+			Return(nil, pgx.ErrNoRows)
+		mockModel.
+			EXPECT().
+			InitUsage(orgCtx, querier.InitUsageParams{
+				OrgID:  orgID,
+				Metric: UsageMetricSms,
+				Day:    pgtype.Date{Time: nowTime.In(cst), Valid: true},
+			}).
+			Return(nil)
+		// the quota is consumed before the code is sent
+		consume := mockModel.
+			EXPECT().
+			ConsumeUsage(orgCtx, querier.ConsumeUsageParams{
+				OrgID:  orgID,
+				Metric: UsageMetricSms,
+				Day:    pgtype.Date{Time: nowTime.In(cst), Valid: true},
+				Since:  pgtype.Date{Time: time.Date(2024, 3, 1, 0, 0, 0, 0, cst), Valid: true},
+				Quota:  10,
+			}).
+			Return(consumed, nil)
+		if consumed == 1 {
+			mockModel.
+				EXPECT().
+				UpsertPhoneCode(ctx, querier.UpsertPhoneCodeParams{
+					Phone:     phone,
+					Typ:       typ,
+					Code:      code,
+					ExpiredAt: nowTime.Add(ExpireDuration),
+				}).
+				Return(&querier.PhoneCode{}, nil)
+			mockSMS.
+				EXPECT().
+				GenerateCode().
+				Return(code)
+			mockSMS.
+				EXPECT().
+				SendCode(phone, code).
+				After(consume).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m:          mockModel,
+			smsManager: mockSMS,
+			quotas:     map[string]int64{UsageMetricSms: 10},
+			now: func() time.Time {
+				return nowTime
+			},
+		}
+		err := svc.CreateCode(ctx, apigen.PostAuthCodeJSONBody{
+			Phone: phone,
+			Typ:   apigen.ChangePassword,
+		})
+		if consumed == 1 {
+			assert.NoError(t, err)
+		} else {
+			// the org has used up its quota, nothing is sent
+			assert.ErrorIs(t, err, ErrQuotaExceeded)
+		}
+	}
+}
+
 func TestCreateUserWithNewOrg(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -224,25 +335,35 @@ func TestCreateUserWithNewOrg(t *testing.T) {
 		password = "password"
 		userID   = uuid.Must(uuid.NewRandom())
 		orgID    = uuid.Must(uuid.NewRandom())
+		nowTime  = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
 	)
 	salt, hashedPassword, err := utils.GenerateHashAndSalt(password)
 	require.NoError(t, err)
 
 	mockModel := model.NewExtendedMockModelInterface(ctrl)
 
+	// the username and the phone are checked across the orgs
+	txCtx := model.WithBypassRLS(ctx)
+
 	mockModel.
 		EXPECT().
-		IsUsernameExist(ctx, username).
+		IsUsernameExist(txCtx, querier.IsUsernameExistParams{
+			Name:         username,
+			DeletedAfter: nowTime.Add(-AccountDeletionGracePeriod),
+		}).
 		Return(false, nil)
 
 	mockModel.
 		EXPECT().
-		IsPhoneExist(ctx, phone).
+		IsPhoneExist(txCtx, querier.IsPhoneExistParams{
+			Phone:        phone,
+			DeletedAfter: nowTime.Add(-AccountDeletionGracePeriod),
+		}).
 		Return(false, nil)
 
 	mockModel.
 		EXPECT().
-		CreateUser(ctx, querier.CreateUserParams{
+		CreateUser(txCtx, querier.CreateUserParams{
 			Name:         username,
 			Phone:        phone,
 			PasswordHash: hashedPassword,
@@ -250,12 +371,15 @@ func TestCreateUserWithNewOrg(t *testing.T) {
 			OrgID:        orgID,
 		}).
 		Return(&querier.User{
-			ID: userID,
+			ID:    userID,
+			OrgID: orgID,
+			Name:  username,
+			Phone: phone,
 		}, nil)
 
 	mockModel.
 		EXPECT().
-		CreateOrg(ctx, "mike的小组").
+		CreateOrg(txCtx, "mike的小组").
 		Return(&querier.Org{
 			ID:   orgID,
 			Name: "mike的小组",
@@ -263,13 +387,29 @@ func TestCreateUserWithNewOrg(t *testing.T) {
 
 	mockModel.
 		EXPECT().
-		UpdateOrgOwnerID(ctx, querier.UpdateOrgOwnerIDParams{
+		UpdateOrgOwnerID(txCtx, querier.UpdateOrgOwnerIDParams{
 			OwnerID: uuid.NullUUID{Valid: true, UUID: userID},
 			ID:      orgID,
 		})
 
+	// the events are written in the same transaction
+	mockModel.
+		EXPECT().
+		CreateOutboxEvent(txCtx, querier.CreateOutboxEventParams{
+			Typ:     EventOrgCreated,
+			Payload: []byte(`{"orgId":"` + orgID.String() + `","ownerId":"` + userID.String() + `","name":"mike的小组"}`),
+		})
+
+	mockModel.
+		EXPECT().
+		CreateOutboxEvent(txCtx, querier.CreateOutboxEventParams{
+			Typ:     EventUserRegistered,
+			Payload: []byte(`{"userId":"` + userID.String() + `","orgId":"` + orgID.String() + `","username":"mike","phone":"18088805143"}`),
+		})
+
 	svc := &Service{
-		m: mockModel,
+		m:   mockModel,
+		now: func() time.Time { return nowTime },
 		generateHashAndSalt: func(password string) (string, string, error) {
 			return salt, hashedPassword, nil
 		},
@@ -282,6 +422,25 @@ func TestCreateUserWithNewOrg(t *testing.T) {
 	})
 
 	assert.NoError(t, err)
+
+	// the reserved usernames are rejected before touching the database
+	for _, reserved := range []string{"admin", "Admin", " ROOT "} {
+		err = svc.CreateUserWithNewOrg(ctx, apigen.PostAuthRegisterJSONBody{
+			Username: reserved,
+			Phone:    phone,
+			Password: password,
+		})
+		assert.ErrorIs(t, err, ErrUsernameReserved, reserved)
+	}
+
+	// so is the phone of the admin
+	svc.adminPhone = "13800000000"
+	err = svc.CreateUserWithNewOrg(ctx, apigen.PostAuthRegisterJSONBody{
+		Username: username,
+		Phone:    svc.adminPhone,
+		Password: password,
+	})
+	assert.ErrorIs(t, err, ErrPhoneReserved)
 }
 
 func TestVerifyCode(t *testing.T) {
@@ -294,9 +453,17 @@ func TestVerifyCode(t *testing.T) {
 		typ   = apigen.Register
 	)
 
-	mockModel := model.NewExtendedMockModelInterface(ctrl)
-
+	// queries must go through the model of the transaction
+	mockModel := model.NewMockModelInterface(ctrl)
+	txModel := model.NewExtendedMockModelInterface(ctrl)
 	mockModel.
+		EXPECT().
+		RunTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(model.ModelInterface) error, opts ...model.TxOption) error {
+			return f(txModel)
+		})
+
+	txModel.
 		EXPECT().
 		GetPhoneCode(gomock.Any(), querier.GetPhoneCodeParams{
 			Phone: phone,
@@ -310,7 +477,7 @@ func TestVerifyCode(t *testing.T) {
 			ExpiredAt: time.Now().Add(5 * time.Minute),
 		}, nil)
 
-	mockModel.
+	txModel.
 		EXPECT().
 		MarkPhoneCodeUsed(gomock.Any(), querier.MarkPhoneCodeUsedParams{
 			Phone: phone,
@@ -463,6 +630,12 @@ func TestVerifyLoginInfo(t *testing.T) {
 					Return([]string{
 						"rule1",
 					}, nil)
+				mockModel.
+					EXPECT().
+					GetOrgSubscription(gomock.Any(), testCase.userInfo.OrgID).
+					Return(&querier.OrgSubscription{
+						Plan: PlanPro.Name,
+					}, nil)
 			}
 		} else {
 			mockModel.
@@ -484,7 +657,7 @@ func TestVerifyLoginInfo(t *testing.T) {
 			assert.True(t, errors.Is(err, testCase.expectedErr))
 		} else {
 			assert.NoError(t, err)
-			assert.Equal(t, []string{"rule1"}, rules)
+			assert.Equal(t, []string{"rule1", model.RulePremium}, rules)
 		}
 	}
 }
@@ -506,6 +679,10 @@ func TestChangePassword(t *testing.T) {
 		PasswordHash: hashedPassword,
 		PasswordSalt: salt,
 	}).Return(nil)
+	mockModel.EXPECT().CreateOutboxEvent(gomock.Any(), querier.CreateOutboxEventParams{
+		Typ:     EventPasswordChanged,
+		Payload: []byte(`{"phone":"18088805143"}`),
+	}).Return(nil)
 	svc := &Service{
 		m: mockModel,
 		generateHashAndSalt: func(password string) (string, string, error) {
@@ -526,6 +703,7 @@ func TestGetOrgsInfo(t *testing.T) {
 	testCases := []struct {
 		orgId       uuid.UUID
 		orgInfo     *querier.Org
+		balance     int64
 		expectedErr error
 	}{
 		{
@@ -534,6 +712,7 @@ func TestGetOrgsInfo(t *testing.T) {
 				ID:   orgId,
 				Name: "orgName",
 			},
+			balance:     100,
 			expectedErr: nil,
 		},
 		{
@@ -552,6 +731,10 @@ func TestGetOrgsInfo(t *testing.T) {
 				EXPECT().
 				GetOrgInfoByOrgId(gomock.Any(), testCase.orgId).
 				Return(testCase.orgInfo, nil)
+			mockModel.
+				EXPECT().
+				GetOrgBalance(gomock.Any(), testCase.orgId).
+				Return(testCase.balance, nil)
 		} else {
 			mockModel.
 				EXPECT().
@@ -564,6 +747,1864 @@ func TestGetOrgsInfo(t *testing.T) {
 		} else {
 			assert.NoError(t, err)
 			assert.Equal(t, org.Id, testCase.orgId)
+			assert.Equal(t, org.Balance, testCase.balance)
+		}
+	}
+}
+
+func TestCreateTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx    = context.Background()
+		orgID  = uuid.Must(uuid.NewRandom())
+		teamID = uuid.Must(uuid.NewRandom())
+		name   = "研发部"
+	)
+
+	testCases := []struct {
+		exist       bool
+		expectedErr error
+	}{
+		{
+			exist:       false,
+			expectedErr: nil,
+		},
+		{
+			exist:       true,
+			expectedErr: ErrTeamAlreadyExist,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		mockModel.
+			EXPECT().
+			IsTeamNameExist(ctx, querier.IsTeamNameExistParams{
+				OrgID: orgID,
+				Name:  name,
+			}).
+			Return(testCase.exist, nil)
+		if !testCase.exist {
+			mockModel.
+				EXPECT().
+				CreateTeam(ctx, querier.CreateTeamParams{
+					OrgID: orgID,
+					Name:  name,
+				}).
+				Return(&querier.Team{
+					ID:    teamID,
+					OrgID: orgID,
+					Name:  name,
+				}, nil)
+		}
+
+		svc := &Service{
+			m: mockModel,
+		}
+		team, err := svc.CreateTeam(ctx, orgID, name)
+		if testCase.expectedErr != nil {
+			assert.True(t, errors.Is(err, testCase.expectedErr))
+		} else {
+			require.NoError(t, err)
+			assert.Equal(t, teamID, team.Id)
+		}
+	}
+}
+
+func TestAddTeamMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx    = context.Background()
+		orgID  = uuid.Must(uuid.NewRandom())
+		teamID = uuid.Must(uuid.NewRandom())
+		userID = uuid.Must(uuid.NewRandom())
+	)
+
+	testCases := []struct {
+		userOrgID   uuid.UUID
+		expectedErr error
+	}{
+		{
+			userOrgID:   orgID,
+			expectedErr: nil,
+		},
+		{
+			userOrgID:   uuid.Must(uuid.NewRandom()),
+			expectedErr: ErrUserNotInOrg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		mockModel.
+			EXPECT().
+			GetTeam(ctx, querier.GetTeamParams{
+				ID:    teamID,
+				OrgID: orgID,
+			}).
+			Return(&querier.Team{
+				ID:    teamID,
+				OrgID: orgID,
+			}, nil)
+		mockModel.
+			EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&querier.User{
+				ID:    userID,
+				OrgID: testCase.userOrgID,
+			}, nil)
+		if testCase.expectedErr == nil {
+			mockModel.
+				EXPECT().
+				AddTeamMember(ctx, querier.AddTeamMemberParams{
+					TeamID: teamID,
+					UserID: userID,
+				}).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m: mockModel,
+		}
+		err := svc.AddTeamMember(ctx, orgID, teamID, userID)
+		if testCase.expectedErr != nil {
+			assert.True(t, errors.Is(err, testCase.expectedErr))
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestGrantTeamAccessRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx    = context.Background()
+		orgID  = uuid.Must(uuid.NewRandom())
+		teamID = uuid.Must(uuid.NewRandom())
+		ruleID = uuid.Must(uuid.NewRandom())
+	)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	svc := &Service{
+		m: mockModel,
+	}
+
+	// the platform rules cannot be granted by the org owners
+	for _, rule := range model.AllRules {
+		assert.ErrorIs(t, svc.GrantTeamAccessRule(ctx, orgID, teamID, rule), ErrReservedAccessRule)
+	}
+
+	mockModel.
+		EXPECT().
+		GetTeam(ctx, querier.GetTeamParams{
+			ID:    teamID,
+			OrgID: orgID,
+		}).
+		Return(&querier.Team{
+			ID:    teamID,
+			OrgID: orgID,
+		}, nil)
+	mockModel.
+		EXPECT().
+		GetAccessRule(ctx, "reports").
+		Return(&querier.AccessRule{
+			ID:   ruleID,
+			Name: "reports",
+		}, nil)
+	mockModel.
+		EXPECT().
+		AddTeamAccessRule(ctx, querier.AddTeamAccessRuleParams{
+			TeamID: teamID,
+			RuleID: ruleID,
+		}).
+		Return(nil)
+	assert.NoError(t, svc.GrantTeamAccessRule(ctx, orgID, teamID, "reports"))
+}
+
+func TestDebit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = context.Background()
+		orgID = uuid.Must(uuid.NewRandom())
+		txnID = uuid.Must(uuid.NewRandom())
+	)
+
+	testCases := []struct {
+		balance         int64
+		amount          int64
+		expectedBalance int64
+		expectedErr     error
+	}{
+		{
+			balance:         100,
+			amount:          30,
+			expectedBalance: 70,
+			expectedErr:     nil,
+		},
+		{
+			balance:     20,
+			amount:      30,
+			expectedErr: ErrInsufficientBalance,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		mockModel.
+			EXPECT().
+			InitOrgBalance(ctx, orgID).
+			Return(nil)
+		mockModel.
+			EXPECT().
+			GetOrgBalanceForUpdate(ctx, orgID).
+			Return(testCase.balance, nil)
+		if testCase.expectedErr == nil {
+			mockModel.
+				EXPECT().
+				CreateLedgerTransaction(ctx, querier.CreateLedgerTransactionParams{
+					OrgID:       orgID,
+					Typ:         string(TradeTypeConsume),
+					Amount:      testCase.amount,
+					Description: "test",
+				}).
+				Return(&querier.LedgerTransaction{ID: txnID}, nil)
+			mockModel.
+				EXPECT().
+				CreateLedgerEntry(ctx, querier.CreateLedgerEntryParams{
+					TransactionID: txnID,
+					Account:       ledgerAccountOrg,
+					OrgID:         uuid.NullUUID{Valid: true, UUID: orgID},
+					Amount:        -testCase.amount,
+				}).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				CreateLedgerEntry(ctx, querier.CreateLedgerEntryParams{
+					TransactionID: txnID,
+					Account:       ledgerAccountRevenue,
+					Amount:        testCase.amount,
+				}).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+					OrgID:   orgID,
+					Balance: testCase.expectedBalance,
+				}).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				CreateOutboxEvent(ctx, querier.CreateOutboxEventParams{
+					Typ:     EventBalanceChanged,
+					Payload: []byte(fmt.Sprintf(`{"orgId":"%s","transactionId":"%s","type":"consume","amount":%d,"balance":%d}`, orgID, txnID, -testCase.amount, testCase.expectedBalance)),
+				}).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m: mockModel,
+		}
+		balance, err := svc.Debit(ctx, orgID, testCase.amount, TradeTypeConsume, "test")
+		if testCase.expectedErr != nil {
+			assert.True(t, errors.Is(err, testCase.expectedErr))
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedBalance, balance)
 		}
 	}
 }
+
+func TestHandlePaymentNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = model.WithBypassRLS(context.Background())
+		orgID   = uuid.Must(uuid.NewRandom())
+		orderID = uuid.Must(uuid.NewRandom())
+		txnID   = uuid.Must(uuid.NewRandom())
+		tradeID = "trade"
+		amount  = int64(100)
+		header  = http.Header{}
+		body    = []byte("body")
+	)
+
+	testCases := []struct {
+		status      TradeStatus
+		parseErr    error
+		expectedErr error
+	}{
+		{
+			status:      TradeStatusPending,
+			expectedErr: nil,
+		},
+		{
+			status:      TradeStatusExpired,
+			expectedErr: nil,
+		},
+		{
+			// notifications are retried by the provider, paid orders must not be credited again
+			status:      TradeStatusPaid,
+			expectedErr: nil,
+		},
+		{
+			parseErr:    payment.ErrInvalidSignature,
+			expectedErr: ErrInvalidPaymentNotification,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		mockPayment := payment.NewMockPaymentProviderInterface(ctrl)
+		mockPayment.
+			EXPECT().
+			Name().
+			Return(payment.ProviderFake)
+
+		if testCase.parseErr != nil {
+			mockPayment.
+				EXPECT().
+				ParseNotification(header, body).
+				Return(nil, testCase.parseErr)
+		} else {
+			mockPayment.
+				EXPECT().
+				ParseNotification(header, body).
+				Return(&payment.Notification{
+					OrderID: orderID.String(),
+					TradeID: tradeID,
+					Paid:    true,
+					Amount:  amount,
+				}, nil)
+			mockModel.
+				EXPECT().
+				GetRechargeOrderForUpdate(ctx, orderID).
+				Return(&querier.RechargeOrder{
+					ID:     orderID,
+					OrgID:  orgID,
+					Amount: amount,
+					Status: string(testCase.status),
+				}, nil)
+		}
+
+		if testCase.parseErr == nil && testCase.status != TradeStatusPaid {
+			mockModel.
+				EXPECT().
+				InitOrgBalance(ctx, orgID).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				GetOrgBalanceForUpdate(ctx, orgID).
+				Return(int64(0), nil)
+			mockModel.
+				EXPECT().
+				CreateLedgerTransaction(ctx, gomock.Any()).
+				Return(&querier.LedgerTransaction{ID: txnID}, nil)
+			mockModel.
+				EXPECT().
+				CreateLedgerEntry(ctx, gomock.Any()).
+				Return(nil).
+				Times(2)
+			mockModel.
+				EXPECT().
+				UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+					OrgID:   orgID,
+					Balance: amount,
+				}).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				CreateOutboxEvent(ctx, gomock.Any()).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				MarkRechargeOrderPaid(ctx, querier.MarkRechargeOrderPaidParams{
+					ID:                  orderID,
+					ProviderTradeID:     &tradeID,
+					LedgerTransactionID: uuid.NullUUID{Valid: true, UUID: txnID},
+				}).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m:       mockModel,
+			payment: mockPayment,
+		}
+		err := svc.HandlePaymentNotification(ctx, payment.ProviderFake, header, body)
+		if testCase.expectedErr != nil {
+			assert.True(t, errors.Is(err, testCase.expectedErr))
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestPaymentDisabled(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		orgID = uuid.Must(uuid.NewRandom())
+	)
+
+	// the fake provider is only used if it is enabled explicitly
+	provider, err := payment.NewPaymentProvider(&config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, payment.ProviderNone, provider.Name())
+	provider, err = payment.NewPaymentProvider(&config.Config{FakePayment: true})
+	require.NoError(t, err)
+	assert.Equal(t, payment.ProviderFake, provider.Name())
+
+	svc := &Service{
+		payment: &payment.NonePaymentProvider{},
+	}
+	_, err = svc.CreateRechargeOrder(ctx, orgID, uuid.Must(uuid.NewRandom()), 100)
+	assert.ErrorIs(t, err, ErrPaymentProviderNotFound)
+
+	body := []byte(`{"orderId":"` + uuid.NewString() + `","paid":true,"amount":100}`)
+	header := http.Header{}
+	header.Set(payment.FakeSignatureHeader, payment.FakeSign(body))
+	for _, provider := range []string{payment.ProviderNone, payment.ProviderFake} {
+		assert.ErrorIs(t, svc.HandlePaymentNotification(ctx, provider, header, body), ErrPaymentProviderNotFound)
+	}
+}
+
+func TestConsumeQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = context.Background()
+		orgID   = uuid.Must(uuid.NewRandom())
+		nowTime = time.Date(2024, 3, 14, 18, 0, 0, 0, time.UTC) // 2024-03-15 in China Standard Time
+		today   = pgtype.Date{Time: time.Date(2024, 3, 15, 2, 0, 0, 0, cst), Valid: true}
+		since   = pgtype.Date{Time: time.Date(2024, 3, 1, 0, 0, 0, 0, cst), Valid: true}
+	)
+
+	testCases := []struct {
+		limit    int64
+		expected bool
+	}{
+		{
+			limit:    0,
+			expected: true,
+		},
+		{
+			limit:    10,
+			expected: true,
+		},
+		{
+			limit:    10,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		mockModel.
+			EXPECT().
+			GetOrgSubscription(ctx, orgID).
+			Return(nil, pgx.ErrNoRows)
+		if testCase.limit > 0 {
+			// the check and the increase are done by the database in one update, the
+			// day is passed in China Standard Time
+			mockModel.
+				EXPECT().
+				InitUsage(ctx, querier.InitUsageParams{
+					OrgID:  orgID,
+					Metric: UsageMetricApiCall,
+					Day:    today,
+				}).
+				Return(nil)
+			var consumed int64
+			if testCase.expected {
+				consumed = 1
+			}
+			mockModel.
+				EXPECT().
+				ConsumeUsage(ctx, querier.ConsumeUsageParams{
+					OrgID:  orgID,
+					Metric: UsageMetricApiCall,
+					Day:    today,
+					Since:  since,
+					Quota:  testCase.limit,
+				}).
+				Return(consumed, nil)
+		} else {
+			mockModel.
+				EXPECT().
+				IncreaseUsage(ctx, querier.IncreaseUsageParams{
+					OrgID:  orgID,
+					Metric: UsageMetricApiCall,
+					Day:    today,
+					Count:  1,
+				}).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m:      mockModel,
+			now:    func() time.Time { return nowTime },
+			quotas: map[string]int64{UsageMetricApiCall: testCase.limit},
+		}
+		ok, err := svc.ConsumeQuota(ctx, orgID, UsageMetricApiCall)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, ok)
+	}
+}
+
+func TestRunBilling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx       = model.WithBypassRLS(context.Background())
+		orgID     = uuid.Must(uuid.NewRandom())
+		txnID     = uuid.Must(uuid.NewRandom())
+		nowTime   = time.Date(2024, 3, 15, 10, 0, 0, 0, cst)
+		periodEnd = nowTime.Add(-time.Hour)
+	)
+
+	testCases := []struct {
+		balance           int64
+		cancelAtPeriodEnd bool
+		expectRenew       bool
+	}{
+		{
+			balance:     PlanPro.Price,
+			expectRenew: true,
+		},
+		{
+			balance:     PlanPro.Price - 1,
+			expectRenew: false,
+		},
+		{
+			balance:           PlanPro.Price,
+			cancelAtPeriodEnd: true,
+			expectRenew:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		mockModel := model.NewExtendedMockModelInterface(ctrl)
+		gomock.InOrder(
+			mockModel.
+				EXPECT().
+				GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+					CurrentPeriodEnd: nowTime,
+				}).
+				Return(&querier.OrgSubscription{
+					OrgID:             orgID,
+					Plan:              PlanPro.Name,
+					CurrentPeriodEnd:  periodEnd,
+					CancelAtPeriodEnd: testCase.cancelAtPeriodEnd,
+				}, nil),
+			mockModel.
+				EXPECT().
+				GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+					CurrentPeriodEnd: nowTime,
+				}).
+				Return(nil, pgx.ErrNoRows),
+		)
+		if !testCase.cancelAtPeriodEnd {
+			mockModel.
+				EXPECT().
+				InitOrgBalance(ctx, orgID).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				GetOrgBalanceForUpdate(ctx, orgID).
+				Return(testCase.balance, nil)
+		}
+		if testCase.expectRenew {
+			mockModel.
+				EXPECT().
+				CreateLedgerTransaction(ctx, gomock.Any()).
+				Return(&querier.LedgerTransaction{ID: txnID}, nil)
+			mockModel.
+				EXPECT().
+				CreateLedgerEntry(ctx, gomock.Any()).
+				Return(nil).
+				Times(2)
+			mockModel.
+				EXPECT().
+				UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+					OrgID:   orgID,
+					Balance: 0,
+				}).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				CreateOutboxEvent(ctx, gomock.Any()).
+				Return(nil)
+			mockModel.
+				EXPECT().
+				RenewOrgSubscription(ctx, querier.RenewOrgSubscriptionParams{
+					OrgID:              orgID,
+					CurrentPeriodStart: periodEnd,
+					CurrentPeriodEnd:   periodEnd.AddDate(0, 1, 0),
+				}).
+				Return(nil)
+		} else {
+			mockModel.
+				EXPECT().
+				DeleteOrgSubscription(ctx, orgID).
+				Return(nil)
+		}
+
+		svc := &Service{
+			m:   mockModel,
+			now: func() time.Time { return nowTime },
+		}
+		assert.NoError(t, svc.RunBilling(ctx))
+	}
+
+	// a subscription failing to be billed does not block the others
+	var (
+		mockModel = model.NewExtendedMockModelInterface(ctrl)
+		failedID  = uuid.Must(uuid.NewRandom())
+	)
+	gomock.InOrder(
+		mockModel.
+			EXPECT().
+			GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+				CurrentPeriodEnd: nowTime,
+			}).
+			Return(&querier.OrgSubscription{
+				OrgID:            failedID,
+				Plan:             PlanPro.Name,
+				CurrentPeriodEnd: periodEnd,
+			}, nil),
+		mockModel.
+			EXPECT().
+			InitOrgBalance(ctx, failedID).
+			Return(errors.New("timeout")),
+		mockModel.
+			EXPECT().
+			GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+				CurrentPeriodEnd: nowTime,
+				Excluded:         []uuid.UUID{failedID},
+			}).
+			Return(&querier.OrgSubscription{
+				OrgID:             orgID,
+				Plan:              PlanPro.Name,
+				CurrentPeriodEnd:  periodEnd,
+				CancelAtPeriodEnd: true,
+			}, nil),
+		mockModel.
+			EXPECT().
+			DeleteOrgSubscription(ctx, orgID).
+			Return(nil),
+		mockModel.
+			EXPECT().
+			GetDueOrgSubscriptionForUpdate(ctx, querier.GetDueOrgSubscriptionForUpdateParams{
+				CurrentPeriodEnd: nowTime,
+				Excluded:         []uuid.UUID{failedID},
+			}).
+			Return(nil, pgx.ErrNoRows),
+	)
+	svc := &Service{
+		m:   mockModel,
+		now: func() time.Time { return nowTime },
+	}
+	assert.Error(t, svc.RunBilling(ctx))
+}
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx         = context.Background()
+		orgID       = uuid.Must(uuid.NewRandom())
+		txnID       = uuid.Must(uuid.NewRandom())
+		periodStart = time.Date(2024, 3, 1, 0, 0, 0, 0, cst)
+		periodEnd   = periodStart.AddDate(0, 1, 0)
+		// 10 of the 31 days of the period are left
+		nowTime = periodEnd.AddDate(0, 0, -10)
+	)
+
+	// resubscribing to the canceled plan resumes it without charging again
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		GetOrgSubscriptionForUpdate(ctx, orgID).
+		Return(&querier.OrgSubscription{
+			OrgID:              orgID,
+			Plan:               PlanPro.Name,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+			CancelAtPeriodEnd:  true,
+		}, nil)
+	mockModel.
+		EXPECT().
+		ResumeOrgSubscription(ctx, orgID).
+		Return(&querier.OrgSubscription{
+			OrgID:              orgID,
+			Plan:               PlanPro.Name,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+		}, nil)
+	svc := &Service{
+		m:   mockModel,
+		now: func() time.Time { return nowTime },
+	}
+	sub, err := svc.Subscribe(ctx, orgID, PlanPro.Name, nil)
+	require.NoError(t, err)
+	assert.Equal(t, periodStart, sub.CurrentPeriodStart)
+	assert.False(t, sub.CancelAtPeriodEnd)
+
+	// switching plans refunds the part of the period left
+	const planTest = "test"
+	AllPlans = append(AllPlans, &Plan{Name: planTest, Price: 3100})
+	defer func() {
+		AllPlans = AllPlans[:len(AllPlans)-1]
+	}()
+	mockModel = model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		GetOrgSubscriptionForUpdate(ctx, orgID).
+		Return(&querier.OrgSubscription{
+			OrgID:              orgID,
+			Plan:               planTest,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+		}, nil)
+	mockModel.
+		EXPECT().
+		InitOrgBalance(ctx, orgID).
+		Return(nil).
+		Times(2)
+	gomock.InOrder(
+		mockModel.
+			EXPECT().
+			GetOrgBalanceForUpdate(ctx, orgID).
+			Return(PlanPro.Price-1000, nil),
+		mockModel.
+			EXPECT().
+			GetOrgBalanceForUpdate(ctx, orgID).
+			Return(PlanPro.Price, nil),
+	)
+	mockModel.
+		EXPECT().
+		CreateLedgerTransaction(ctx, gomock.Any()).
+		Return(&querier.LedgerTransaction{ID: txnID}, nil).
+		Times(2)
+	mockModel.
+		EXPECT().
+		CreateLedgerEntry(ctx, gomock.Any()).
+		Return(nil).
+		Times(4)
+	gomock.InOrder(
+		mockModel.
+			EXPECT().
+			UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+				OrgID:   orgID,
+				Balance: PlanPro.Price,
+			}).
+			Return(nil),
+		mockModel.
+			EXPECT().
+			UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+				OrgID:   orgID,
+				Balance: 0,
+			}).
+			Return(nil),
+	)
+	mockModel.
+		EXPECT().
+		CreateOutboxEvent(ctx, gomock.Any()).
+		Return(nil).
+		Times(2)
+	mockModel.
+		EXPECT().
+		UpsertOrgSubscription(ctx, querier.UpsertOrgSubscriptionParams{
+			OrgID:              orgID,
+			Plan:               PlanPro.Name,
+			CurrentPeriodStart: nowTime,
+			CurrentPeriodEnd:   nowTime.AddDate(0, 1, 0),
+		}).
+		Return(&querier.OrgSubscription{
+			OrgID: orgID,
+			Plan:  PlanPro.Name,
+		}, nil)
+	svc.m = mockModel
+	_, err = svc.Subscribe(ctx, orgID, PlanPro.Name, nil)
+	require.NoError(t, err)
+
+	// downgrading to the free plan refunds the part of the period left as well
+	mockModel = model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		GetOrgSubscriptionForUpdate(ctx, orgID).
+		Return(&querier.OrgSubscription{
+			OrgID:              orgID,
+			Plan:               planTest,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+			Version:            2,
+		}, nil)
+	mockModel.
+		EXPECT().
+		InitOrgBalance(ctx, orgID).
+		Return(nil)
+	mockModel.
+		EXPECT().
+		GetOrgBalanceForUpdate(ctx, orgID).
+		Return(int64(0), nil)
+	mockModel.
+		EXPECT().
+		CreateLedgerTransaction(ctx, querier.CreateLedgerTransactionParams{
+			OrgID:       orgID,
+			Typ:         string(TradeTypeRefund),
+			Amount:      1000,
+			Description: "套餐 test 未使用部分退款",
+		}).
+		Return(&querier.LedgerTransaction{ID: txnID}, nil)
+	mockModel.
+		EXPECT().
+		CreateLedgerEntry(ctx, gomock.Any()).
+		Return(nil).
+		Times(2)
+	mockModel.
+		EXPECT().
+		UpdateOrgBalance(ctx, querier.UpdateOrgBalanceParams{
+			OrgID:   orgID,
+			Balance: 1000,
+		}).
+		Return(nil)
+	mockModel.
+		EXPECT().
+		CreateOutboxEvent(ctx, gomock.Any()).
+		Return(nil)
+	mockModel.
+		EXPECT().
+		DeleteOrgSubscription(ctx, orgID).
+		Return(nil)
+	mockModel.
+		EXPECT().
+		GetOrgSubscription(model.WithPrimary(ctx), orgID).
+		Return(nil, pgx.ErrNoRows)
+	svc.m = mockModel
+	sub, err = svc.Subscribe(ctx, orgID, PlanFree.Name, utils.Ptr[int32](2))
+	require.NoError(t, err)
+	assert.Equal(t, PlanFree.Name, sub.Plan)
+
+	// the subscription has been modified since it was read
+	mockModel = model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		GetOrgSubscriptionForUpdate(ctx, orgID).
+		Return(&querier.OrgSubscription{
+			OrgID:              orgID,
+			Plan:               planTest,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+			Version:            3,
+		}, nil).
+		Times(2)
+	svc.m = mockModel
+	_, err = svc.Subscribe(ctx, orgID, PlanPro.Name, utils.Ptr[int32](2))
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	_, err = svc.Subscribe(ctx, orgID, PlanFree.Name, utils.Ptr[int32](2))
+	assert.ErrorIs(t, err, ErrVersionConflict)
+
+	// version 0 stands for the free plan
+	mockModel = model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		GetOrgSubscriptionForUpdate(ctx, orgID).
+		Return(nil, pgx.ErrNoRows)
+	svc.m = mockModel
+	_, err = svc.Subscribe(ctx, orgID, PlanPro.Name, utils.Ptr[int32](1))
+	assert.ErrorIs(t, err, ErrVersionConflict)
+}
+
+func TestGetStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx         = context.Background()
+		orgID       = uuid.Must(uuid.NewRandom())
+		statementID = uuid.Must(uuid.NewRandom())
+		nowTime     = time.Date(2024, 3, 15, 10, 0, 0, 0, cst)
+		periodStart = time.Date(2024, 2, 1, 0, 0, 0, 0, cst)
+		periodEnd   = time.Date(2024, 3, 1, 0, 0, 0, 0, cst)
+	)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	svc := &Service{
+		m:   mockModel,
+		now: func() time.Time { return nowTime },
+	}
+
+	_, err := svc.GetStatement(ctx, orgID, "2024-3")
+	assert.ErrorIs(t, err, ErrInvalidStatementMonth)
+	_, err = svc.GetStatement(ctx, orgID, "2024-03")
+	assert.ErrorIs(t, err, ErrStatementPeriodNotEnded)
+
+	// the org is created in 2024-02, no statement is issued before it
+	org := &querier.Org{
+		ID:        orgID,
+		CreatedAt: time.Date(2024, 2, 20, 0, 0, 0, 0, cst),
+	}
+	mockModel.
+		EXPECT().
+		GetOrgStatement(ctx, querier.GetOrgStatementParams{
+			OrgID:       orgID,
+			PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, cst),
+		}).
+		Return(nil, pgx.ErrNoRows)
+	mockModel.
+		EXPECT().
+		GetOrgInfoByOrgId(ctx, orgID).
+		Return(org, nil).
+		Times(2)
+	_, err = svc.GetStatement(ctx, orgID, "2024-01")
+	assert.ErrorIs(t, err, ErrStatementBeforeOrg)
+
+	mockModel.
+		EXPECT().
+		GetOrgStatement(ctx, querier.GetOrgStatementParams{
+			OrgID:       orgID,
+			PeriodStart: periodStart,
+		}).
+		Return(nil, pgx.ErrNoRows)
+	mockModel.
+		EXPECT().
+		GetOrgLedgerBalanceBefore(ctx, querier.GetOrgLedgerBalanceBeforeParams{
+			OrgID:  uuid.NullUUID{Valid: true, UUID: orgID},
+			Before: periodStart,
+		}).
+		Return(int64(1000), nil)
+	mockModel.
+		EXPECT().
+		GetOrgLedgerBalanceBefore(ctx, querier.GetOrgLedgerBalanceBeforeParams{
+			OrgID:  uuid.NullUUID{Valid: true, UUID: orgID},
+			Before: periodEnd,
+		}).
+		Return(int64(600), nil)
+	mockModel.
+		EXPECT().
+		NextInvoiceNo(ctx, int32(2024)).
+		Return(int64(42), nil)
+	mockModel.
+		EXPECT().
+		CreateOrgStatement(ctx, querier.CreateOrgStatementParams{
+			OrgID:          orgID,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			InvoiceNo:      "INV2024000042",
+			OpeningBalance: 1000,
+			ClosingBalance: 600,
+		}).
+		Return(&querier.OrgStatement{
+			ID:             statementID,
+			OrgID:          orgID,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			InvoiceNo:      "INV2024000042",
+			OpeningBalance: 1000,
+			ClosingBalance: 600,
+			CreatedAt:      nowTime,
+		}, nil)
+	mockModel.
+		EXPECT().
+		GetOrgLedgerSummary(ctx, querier.GetOrgLedgerSummaryParams{
+			OrgID:       uuid.NullUUID{Valid: true, UUID: orgID},
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+		}).
+		Return([]*querier.GetOrgLedgerSummaryRow{
+			{Typ: string(TradeTypeConsume), Count: 2, Amount: -900},
+			{Typ: string(TradeTypeRecharge), Count: 1, Amount: 500},
+		}, nil)
+
+	detail, err := svc.GetStatement(ctx, orgID, "2024-02")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02", detail.Statement.Month)
+	assert.Equal(t, "INV2024000042", detail.Statement.InvoiceNo)
+	assert.Len(t, detail.Items, 2)
+
+	body, err := RenderStatementCSV(detail)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "consume,2,-9.00")
+}
+
+func TestHandleDingTalkCallback(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		timestamp = strconv.FormatInt(time.Now().UnixMilli(), 10)
+		stale     = strconv.FormatInt(time.Now().Add(-time.Hour).UnixMilli(), 10)
+		nonce     = "abcdefgh"
+		encrypt   = `{"EventType":"user_add_org","CorpId":"corp","UserId":["u1","u2"]}`
+		body      = []byte(`{"encrypt":` + strconv.Quote(encrypt) + `}`)
+	)
+
+	svc := &Service{
+		dingtalk: &dingtalk.FakeDingTalkClient{},
+	}
+
+	_, err := svc.HandleDingTalkCallback(ctx, "invalid", timestamp, nonce, body)
+	assert.ErrorIs(t, err, ErrInvalidDingTalkCallback)
+	// replayed events are rejected
+	_, err = svc.HandleDingTalkCallback(ctx, dingtalk.Sign(dingtalk.FakeToken, stale, nonce, encrypt), stale, nonce, body)
+	assert.ErrorIs(t, err, ErrInvalidDingTalkCallback)
+
+	res, err := svc.HandleDingTalkCallback(ctx, dingtalk.Sign(dingtalk.FakeToken, timestamp, nonce, encrypt), timestamp, nonce, body)
+	require.NoError(t, err)
+	assert.Equal(t, ddCallbackSuccess, res.Encrypt)
+	assert.Equal(t, dingtalk.Sign(dingtalk.FakeToken, res.TimeStamp, res.Nonce, res.Encrypt), res.MsgSignature)
+
+	// the payload is decoded into the typed event of its type
+	event := &dingtalk.Event{
+		Type:   string(DdWorkEventBpmsInstanceChange),
+		CorpID: "corp",
+		Raw:    []byte(`{"EventType":"bpms_instance_change","corpId":"corp","processInstanceId":"p1","type":"finish","result":"agree","createTime":1700000000000}`),
+	}
+	var approval dingtalk.BpmsInstanceChangeEvent
+	require.NoError(t, event.Decode(&approval))
+	assert.Equal(t, dingtalk.BpmsInstanceChangeEvent{
+		CorpID:            "corp",
+		ProcessInstanceID: "p1",
+		Type:              "finish",
+		Result:            "agree",
+		CreateTime:        1700000000000,
+	}, approval)
+	require.NoError(t, svc.handleDingTalkEvent(ctx, DdWorkEventBpmsInstanceChange, event))
+	event.Raw = []byte(`{"EventType":"user_add_org","UserId":"u1"}`)
+	assert.Error(t, svc.handleDingTalkEvent(ctx, DdWorkEventUserAddOrg, event))
+}
+
+func TestSeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = model.WithBypassRLS(context.Background())
+		orgID   = uuid.Must(uuid.NewRandom())
+		userID  = uuid.Must(uuid.NewRandom())
+		roleID  = uuid.Must(uuid.NewRandom())
+		nowTime = time.Now()
+	)
+
+	// the admin phone has no default, it is required to seed the admin
+	fixtures, err := LoadSeedFixtures(&config.Seed{Env: "prod", AdminPassword: "123456"}, "")
+	require.NoError(t, err)
+	require.NotNil(t, fixtures.Admin)
+	assert.Empty(t, fixtures.Admin.Phone)
+	assert.Error(t, fixtures.validate())
+
+	fixtures, err = LoadSeedFixtures(&config.Seed{Env: "dev", AdminPassword: "123456", AdminPhone: "13800000000"}, "")
+	require.NoError(t, err)
+	require.NotNil(t, fixtures.Admin)
+	assert.Equal(t, "123456", fixtures.Admin.Password)
+	assert.Equal(t, "13800000000", fixtures.Admin.Phone)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		CreateAccessRuleIfNotExists(ctx, gomock.Any()).
+		Return(nil).
+		Times(len(model.AllRules) + len(fixtures.Rules))
+	for _, role := range fixtures.Roles {
+		mockModel.
+			EXPECT().
+			UpsertRole(ctx, querier.UpsertRoleParams{
+				Name:        role.Name,
+				Description: role.Description,
+			}).
+			Return(&querier.Role{ID: roleID, Name: role.Name}, nil)
+		mockModel.
+			EXPECT().
+			RemoveRoleAccessRulesExcept(ctx, querier.RemoveRoleAccessRulesExceptParams{
+				RoleID: roleID,
+				Rules:  role.Rules,
+			}).
+			Return(nil)
+		mockModel.
+			EXPECT().
+			AddRoleAccessRules(ctx, querier.AddRoleAccessRulesParams{
+				RoleID: roleID,
+				Rules:  role.Rules,
+			}).
+			Return(nil)
+	}
+	mockModel.
+		EXPECT().
+		GetUser(ctx, fixtures.Admin.Name).
+		Return(nil, pgx.ErrNoRows)
+	mockModel.
+		EXPECT().
+		CreateOrg(ctx, fixtures.Admin.Org).
+		Return(&querier.Org{ID: orgID}, nil)
+	mockModel.
+		EXPECT().
+		CreateUser(ctx, querier.CreateUserParams{
+			OrgID:        orgID,
+			Name:         fixtures.Admin.Name,
+			Phone:        fixtures.Admin.Phone,
+			PasswordHash: "hash",
+			PasswordSalt: "salt",
+		}).
+		Return(&querier.User{ID: userID, OrgID: orgID}, nil)
+	mockModel.
+		EXPECT().
+		UpdateOrgOwnerID(ctx, querier.UpdateOrgOwnerIDParams{
+			OwnerID: uuid.NullUUID{Valid: true, UUID: userID},
+			ID:      orgID,
+		}).
+		Return(nil)
+	mockModel.
+		EXPECT().
+		UpsertOrgSubscription(ctx, querier.UpsertOrgSubscriptionParams{
+			OrgID:              orgID,
+			Plan:               fixtures.Admin.Plan,
+			CurrentPeriodStart: nowTime,
+			CurrentPeriodEnd:   nowTime.AddDate(0, 1, 0),
+		}).
+		Return(&querier.OrgSubscription{}, nil)
+	for _, role := range fixtures.Admin.Roles {
+		mockModel.
+			EXPECT().
+			AddUserRole(ctx, querier.AddUserRoleParams{
+				UserID: userID,
+				Role:   role,
+			}).
+			Return(nil)
+	}
+
+	svc := &Service{
+		m:   mockModel,
+		now: func() time.Time { return nowTime },
+		generateHashAndSalt: func(password string) (string, string, error) {
+			return "salt", "hash", nil
+		},
+	}
+	require.NoError(t, svc.Seed(ctx, fixtures))
+
+	fixtures.Admin.Roles = append(fixtures.Admin.Roles, "unknown")
+	assert.Error(t, svc.Seed(ctx, fixtures))
+}
+
+func TestSeedAdminMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = model.WithBypassRLS(context.Background())
+		admin = &SeedAdmin{Name: "admin", Phone: "13800000000", Org: "管理员的小组", Roles: []string{"admin"}}
+	)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	svc := &Service{m: mockModel}
+
+	// no roles are granted to a user who only shares the name or the phone of the admin
+	for _, user := range []*querier.User{
+		{ID: uuid.Must(uuid.NewRandom()), Name: "admin", Phone: "13900000000"},
+		{ID: uuid.Must(uuid.NewRandom()), Name: "13800000000", Phone: "13900000000"},
+	} {
+		mockModel.
+			EXPECT().
+			GetUser(ctx, admin.Name).
+			Return(user, nil)
+		assert.Error(t, svc.seedAdmin(ctx, mockModel, admin))
+	}
+
+	adminID := uuid.Must(uuid.NewRandom())
+	mockModel.
+		EXPECT().
+		GetUser(ctx, admin.Name).
+		Return(&querier.User{ID: adminID, Name: admin.Name, Phone: admin.Phone}, nil)
+	mockModel.
+		EXPECT().
+		AddUserRole(ctx, querier.AddUserRoleParams{
+			UserID: adminID,
+			Role:   "admin",
+		}).
+		Return(nil)
+	assert.NoError(t, svc.seedAdmin(ctx, mockModel, admin))
+}
+
+func TestDeleteAccount(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		password = "password"
+		userID   = uuid.Must(uuid.NewRandom())
+		ownerID  = uuid.Must(uuid.NewRandom())
+		orgID    = uuid.Must(uuid.NewRandom())
+	)
+	salt, hashedPassword, err := utils.GenerateHashAndSalt(password)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		password    string
+		orgOwnerID  uuid.UUID
+		members     int64
+		expectedErr error
+	}{
+		{
+			name:       "member leaves the org",
+			password:   password,
+			orgOwnerID: ownerID,
+		},
+		{
+			name:       "owner deletes the org",
+			password:   password,
+			orgOwnerID: userID,
+		},
+		{
+			name:        "owner with other members",
+			password:    password,
+			orgOwnerID:  userID,
+			members:     2,
+			expectedErr: ErrOrgHasMembers,
+		},
+		{
+			name:        "incorrect password",
+			password:    "wrong",
+			orgOwnerID:  userID,
+			expectedErr: ErrIncorrectPassword,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewExtendedMockModelInterface(ctrl)
+			mockModel.
+				EXPECT().
+				GetUserByID(ctx, userID).
+				Return(&querier.User{
+					ID:           userID,
+					OrgID:        orgID,
+					PasswordHash: hashedPassword,
+					PasswordSalt: salt,
+				}, nil)
+
+			if testCase.password == password {
+				mockModel.
+					EXPECT().
+					GetOrgInfoByOrgId(ctx, orgID).
+					Return(&querier.Org{
+						ID:      orgID,
+						OwnerID: uuid.NullUUID{Valid: true, UUID: testCase.orgOwnerID},
+					}, nil)
+			}
+			isOwner := testCase.password == password && testCase.orgOwnerID == userID
+			if isOwner {
+				mockModel.
+					EXPECT().
+					CountOtherOrgMembers(ctx, querier.CountOtherOrgMembersParams{
+						OrgID: orgID,
+						ID:    userID,
+					}).
+					Return(testCase.members, nil)
+			}
+			if testCase.expectedErr == nil {
+				if isOwner {
+					mockModel.EXPECT().DeleteOrgSubscription(ctx, orgID).Return(nil)
+					mockModel.EXPECT().SoftDeleteOrg(ctx, orgID).Return(nil)
+				}
+				mockModel.EXPECT().SoftDeleteUser(ctx, userID).Return(nil)
+			}
+
+			svc := &Service{m: mockModel}
+			err := svc.DeleteAccount(ctx, userID, testCase.password)
+			if testCase.expectedErr != nil {
+				assert.ErrorIs(t, err, testCase.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRenameTeam(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		orgID  = uuid.Must(uuid.NewRandom())
+		teamID = uuid.Must(uuid.NewRandom())
+		team   = &querier.Team{ID: teamID, OrgID: orgID, Name: "dev", Version: 2}
+	)
+	versionOf := func(v int32) *int32 { return &v }
+
+	testCases := []struct {
+		name        string
+		version     *int32
+		updateErr   error
+		expectedErr error
+	}{
+		{
+			name: "without version",
+		},
+		{
+			name:    "matching version",
+			version: versionOf(2),
+		},
+		{
+			name:        "stale version",
+			version:     versionOf(1),
+			expectedErr: ErrVersionConflict,
+		},
+		{
+			name:        "modified after read",
+			version:     versionOf(2),
+			updateErr:   pgx.ErrNoRows,
+			expectedErr: ErrVersionConflict,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewExtendedMockModelInterface(ctrl)
+			mockModel.
+				EXPECT().
+				GetTeam(ctx, querier.GetTeamParams{ID: teamID, OrgID: orgID}).
+				Return(team, nil)
+			if testCase.version == nil || *testCase.version == team.Version {
+				mockModel.
+					EXPECT().
+					IsTeamNameExist(ctx, querier.IsTeamNameExistParams{OrgID: orgID, Name: "ops"}).
+					Return(false, nil)
+				mockModel.
+					EXPECT().
+					UpdateTeamName(ctx, querier.UpdateTeamNameParams{
+						ID:      teamID,
+						OrgID:   orgID,
+						Name:    "ops",
+						Version: testCase.version,
+					}).
+					DoAndReturn(func(ctx context.Context, arg querier.UpdateTeamNameParams) (*querier.Team, error) {
+						if testCase.updateErr != nil {
+							return nil, testCase.updateErr
+						}
+						return &querier.Team{ID: teamID, OrgID: orgID, Name: arg.Name, Version: team.Version + 1}, nil
+					})
+			}
+
+			svc := &Service{m: mockModel}
+			rtn, err := svc.RenameTeam(ctx, orgID, teamID, "ops", testCase.version)
+			if testCase.expectedErr != nil {
+				assert.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int32(3), rtn.Version)
+		})
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		orgID  = uuid.Must(uuid.NewRandom())
+		teamID = uuid.Must(uuid.NewRandom())
+		team   = &querier.Team{ID: teamID, OrgID: orgID, Name: "dev", Version: 2}
+	)
+	versionOf := func(v int32) *int32 { return &v }
+
+	testCases := []struct {
+		name        string
+		version     *int32
+		deleted     int64
+		expectedErr error
+	}{
+		{
+			name:    "without version",
+			deleted: 1,
+		},
+		{
+			name:    "matching version",
+			version: versionOf(2),
+			deleted: 1,
+		},
+		{
+			name:        "stale version",
+			version:     versionOf(1),
+			expectedErr: ErrVersionConflict,
+		},
+		{
+			name:        "modified after read",
+			version:     versionOf(2),
+			deleted:     0,
+			expectedErr: ErrVersionConflict,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewExtendedMockModelInterface(ctrl)
+			mockModel.
+				EXPECT().
+				GetTeam(ctx, querier.GetTeamParams{ID: teamID, OrgID: orgID}).
+				Return(team, nil)
+			if testCase.version == nil || *testCase.version == team.Version {
+				mockModel.
+					EXPECT().
+					DeleteTeam(ctx, querier.DeleteTeamParams{
+						ID:      teamID,
+						OrgID:   orgID,
+						Version: testCase.version,
+					}).
+					Return(testCase.deleted, nil)
+			}
+
+			svc := &Service{m: mockModel}
+			err := svc.DeleteTeam(ctx, orgID, teamID, testCase.version)
+			if testCase.expectedErr != nil {
+				assert.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListOrgMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = context.Background()
+		orgID   = uuid.Must(uuid.NewRandom())
+		nowTime = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		cursor  = &utils.Cursor{CreatedAt: nowTime, ID: uuid.Must(uuid.NewRandom())}
+		members = []*querier.ListOrgMembersRow{
+			{ID: uuid.Must(uuid.NewRandom()), Name: "a_1", CreatedAt: nowTime.Add(time.Minute)},
+			{ID: uuid.Must(uuid.NewRandom()), Name: "a_2", CreatedAt: nowTime.Add(2 * time.Minute)},
+			{ID: uuid.Must(uuid.NewRandom()), Name: "a_3", CreatedAt: nowTime.Add(3 * time.Minute)},
+		}
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	mockModel.
+		EXPECT().
+		ListOrgMembers(ctx, querier.ListOrgMembersParams{
+			OrgID:           orgID,
+			Keyword:         utils.Ptr(`a\_`),
+			CursorCreatedAt: &cursor.CreatedAt,
+			CursorID:        uuid.NullUUID{Valid: true, UUID: cursor.ID},
+			RowLimit:        3,
+		}).
+		Return(members, nil)
+	mockModel.
+		EXPECT().
+		ListOrgMembers(ctx, querier.ListOrgMembersParams{
+			OrgID:      orgID,
+			Descending: true,
+			RowLimit:   3,
+		}).
+		Return(members[:2], nil)
+
+	svc := &Service{m: mockModel}
+
+	page, err := svc.ListOrgMembers(ctx, orgID, PageParams{Limit: 2, Cursor: cursor}, utils.Ptr("a_"))
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "a_2", page.Items[1].Username)
+	require.NotNil(t, page.NextCursor)
+	next, err := utils.DecodeCursor(*page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, members[1].ID, next.ID)
+	assert.True(t, members[1].CreatedAt.Equal(next.CreatedAt))
+
+	page, err = svc.ListOrgMembers(ctx, orgID, PageParams{Limit: 2, Descending: true}, nil)
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestDispatchEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx    = model.WithBypassRLS(context.Background())
+		orgID  = uuid.Must(uuid.NewRandom())
+		userID = uuid.Must(uuid.NewRandom())
+	)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	mockDingTalk := dingtalk.NewMockDingTalkClientInterface(ctrl)
+
+	svc := &Service{
+		m:        mockModel,
+		dingtalk: mockDingTalk,
+	}
+	d := outbox.NewDispatcher(mockModel)
+	svc.RegisterEventHandlers(d)
+
+	// the org balance is opened for the new org
+	mockModel.EXPECT().ClaimNextOutboxEvent(ctx, gomock.Any()).Return(&querier.OutboxEvent{
+		ID:       1,
+		Typ:      EventOrgCreated,
+		Payload:  []byte(`{"orgId":"` + orgID.String() + `","ownerId":"` + userID.String() + `","name":"mike的小组"}`),
+		Attempts: 1,
+	}, nil)
+	mockModel.EXPECT().ListOutboxDeliveries(ctx, int64(1)).Return(nil, nil)
+	mockModel.EXPECT().InitOrgBalance(gomock.Any(), orgID).Return(nil)
+	mockModel.EXPECT().UpsertOutboxDelivery(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.UpsertOutboxDeliveryParams) error {
+			assert.Equal(t, "init_balance", arg.Handler)
+			assert.NotNil(t, arg.DeliveredAt)
+			return nil
+		},
+	)
+	mockModel.EXPECT().MarkOutboxEventDispatched(ctx, int64(1)).Return(nil)
+
+	// the failed robot message is retried later without running the webhooks again
+	mockModel.EXPECT().ClaimNextOutboxEvent(ctx, gomock.Any()).Return(&querier.OutboxEvent{
+		ID:       2,
+		Typ:      EventUserRegistered,
+		Payload:  []byte(`{"userId":"` + userID.String() + `","orgId":"` + orgID.String() + `","username":"mike","phone":"18088805143"}`),
+		Attempts: 2,
+	}, nil)
+	mockModel.EXPECT().ListOutboxDeliveries(ctx, int64(2)).Return([]*querier.OutboxDelivery{
+		{EventID: 2, Handler: "notify_robot", Attempts: 1, LastError: utils.Ptr("timeout")},
+		{EventID: 2, Handler: "webhooks", Attempts: 1, DeliveredAt: utils.Ptr(time.Now())},
+	}, nil)
+	mockDingTalk.EXPECT().SendRobotMessage(gomock.Any(), "新用户注册：mike（18088805143）", nil).Return(errors.New("timeout"))
+	mockModel.EXPECT().UpsertOutboxDelivery(ctx, querier.UpsertOutboxDeliveryParams{
+		EventID:   2,
+		Handler:   "notify_robot",
+		Attempts:  2,
+		LastError: utils.Ptr("failed to send robot message: timeout"),
+	}).Return(nil)
+	mockModel.EXPECT().MarkOutboxEventFailed(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.MarkOutboxEventFailedParams) error {
+			assert.Equal(t, int64(2), arg.ID)
+			assert.Equal(t, "notify_robot: failed to send robot message: timeout", *arg.LastError)
+			return nil
+		},
+	)
+
+	mockModel.EXPECT().ClaimNextOutboxEvent(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
+
+	require.NoError(t, d.DispatchAll(ctx))
+}
+
+func TestTestWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx        = context.Background()
+		orgID      = uuid.Must(uuid.NewRandom())
+		webhookID  = uuid.Must(uuid.NewRandom())
+		deliveryID = uuid.Must(uuid.NewRandom())
+		secret     = "whsec_test"
+	)
+
+	testCases := []struct {
+		status         int
+		expectedStatus string
+	}{
+		{status: http.StatusNoContent, expectedStatus: WebhookDeliveryStatusSucceeded},
+		{status: http.StatusInternalServerError, expectedStatus: WebhookDeliveryStatusFailed},
+	}
+
+	for _, testCase := range testCases {
+		var received http.Header
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, webhook.Verify(secret, r.Header, body, time.Minute, time.Now()))
+			assert.JSONEq(t, `{"id":"`+deliveryID.String()+`","type":"ping","createdAt":"2024-03-01T00:00:00Z","data":{"webhookId":"`+webhookID.String()+`"}}`, string(body))
+			received = r.Header
+			w.WriteHeader(testCase.status)
+			_, _ = w.Write([]byte("internal stack trace"))
+		}))
+
+		mockModel := model.NewMockModelInterface(ctrl)
+		mockModel.
+			EXPECT().
+			GetWebhookEndpoint(ctx, querier.GetWebhookEndpointParams{
+				ID:    webhookID,
+				OrgID: orgID,
+			}).
+			Return(&querier.WebhookEndpoint{
+				ID:      webhookID,
+				OrgID:   orgID,
+				Url:     receiver.URL,
+				Secret:  secret,
+				Events:  []string{WebhookEventMemberJoined},
+				Enabled: true,
+			}, nil)
+		payload := []byte(`{"webhookId":"` + webhookID.String() + `"}`)
+		mockModel.
+			EXPECT().
+			CreateWebhookDelivery(ctx, querier.CreateWebhookDeliveryParams{
+				EndpointID: webhookID,
+				Event:      WebhookEventPing,
+				Payload:    payload,
+			}).
+			Return(&querier.WebhookDelivery{
+				ID:         deliveryID,
+				EndpointID: webhookID,
+				Event:      WebhookEventPing,
+				Payload:    payload,
+				Status:     WebhookDeliveryStatusPending,
+				CreatedAt:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			}, nil)
+		mockModel.
+			EXPECT().
+			UpdateWebhookDeliveryResult(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg querier.UpdateWebhookDeliveryResultParams) (*querier.WebhookDelivery, error) {
+				assert.Equal(t, deliveryID, arg.ID)
+				assert.Equal(t, testCase.expectedStatus, arg.Status)
+				assert.Equal(t, int32(testCase.status), *arg.ResponseCode)
+				return &querier.WebhookDelivery{
+					ID:           deliveryID,
+					EndpointID:   webhookID,
+					Event:        WebhookEventPing,
+					Payload:      payload,
+					Status:       arg.Status,
+					Attempts:     1,
+					ResponseCode: arg.ResponseCode,
+					LastError:    arg.LastError,
+				}, nil
+			})
+
+		// the receiver listens on loopback
+		svc := &Service{
+			m:       mockModel,
+			webhook: webhook.NewSender(&config.Config{Webhook: config.Webhook{AllowPrivateNetwork: true}}),
+		}
+		delivery, err := svc.TestWebhook(ctx, orgID, webhookID)
+		receiver.Close()
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expectedStatus, delivery.Status)
+		assert.Equal(t, int32(testCase.status), *delivery.ResponseCode)
+		assert.Equal(t, webhookID.String(), delivery.Payload["webhookId"])
+		if delivery.LastError != nil {
+			// the response body is not recorded
+			assert.Equal(t, "unexpected status 500", *delivery.LastError)
+		}
+		assert.Equal(t, deliveryID.String(), received.Get(webhook.HeaderID))
+		assert.Equal(t, WebhookEventPing, received.Get(webhook.HeaderEvent))
+	}
+}
+
+func TestRunJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = model.WithBypassRLS(context.Background())
+		orderID = uuid.Must(uuid.NewRandom())
+		args    = []byte(`{"orderId":"` + orderID.String() + `"}`)
+		kind    = ExpireRechargeOrderArgs{}.Kind()
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	svc := &Service{
+		m: mockModel,
+	}
+	w := jobs.NewWorker(&config.Config{Worker: config.Worker{JobTimeout: time.Minute}}, mockModel)
+	svc.RegisterJobHandlers(w)
+
+	// jobs with a unique key are only queued once
+	runAt := time.Date(2024, 3, 1, 0, 15, 0, 0, time.UTC)
+	mockModel.EXPECT().InsertJob(ctx, querier.InsertJobParams{
+		Kind:        kind,
+		Args:        args,
+		UniqueKey:   utils.Ptr(orderID.String()),
+		MaxAttempts: jobs.DefaultMaxAttempts,
+		RunAt:       &runAt,
+	}).Return(int64(0), pgx.ErrNoRows)
+	_, err := jobs.Enqueue(ctx, mockModel, ExpireRechargeOrderArgs{OrderID: orderID}, jobs.RunAt(runAt), jobs.UniqueKey(orderID.String()))
+	assert.ErrorIs(t, err, jobs.ErrJobExists)
+
+	// the job succeeds
+	claimedAt := time.Now()
+	mockModel.EXPECT().ClaimNextJob(ctx, gomock.Any()).Return(&querier.Job{
+		ID: 1, Kind: kind, Args: args, Attempts: 1, MaxAttempts: jobs.DefaultMaxAttempts, LockedAt: &claimedAt,
+	}, nil)
+	mockModel.EXPECT().UpdatePendingRechargeOrderStatus(gomock.Any(), querier.UpdatePendingRechargeOrderStatusParams{
+		ID:     orderID,
+		Status: string(TradeStatusExpired),
+	}).Return(nil)
+	mockModel.EXPECT().CompleteJob(ctx, querier.CompleteJobParams{
+		ID:        1,
+		ClaimedAt: claimedAt,
+	}).Return(int64(1), nil)
+
+	// the failed job is retried later
+	mockModel.EXPECT().ClaimNextJob(ctx, gomock.Any()).Return(&querier.Job{
+		ID: 2, Kind: kind, Args: args, Attempts: 1, MaxAttempts: jobs.DefaultMaxAttempts, LockedAt: &claimedAt,
+	}, nil)
+	mockModel.EXPECT().UpdatePendingRechargeOrderStatus(gomock.Any(), gomock.Any()).Return(errors.New("timeout"))
+	mockModel.EXPECT().RetryJob(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.RetryJobParams) (int64, error) {
+			assert.Equal(t, int64(2), arg.ID)
+			assert.Contains(t, *arg.LastError, "timeout")
+			assert.True(t, arg.RunAt.After(time.Now()))
+			assert.Equal(t, claimedAt, arg.ClaimedAt)
+			return 1, nil
+		},
+	)
+
+	// the job is failed once it runs out of attempts
+	mockModel.EXPECT().ClaimNextJob(ctx, gomock.Any()).Return(&querier.Job{
+		ID: 2, Kind: kind, Args: args, Attempts: jobs.DefaultMaxAttempts, MaxAttempts: jobs.DefaultMaxAttempts, LockedAt: &claimedAt,
+	}, nil)
+	mockModel.EXPECT().UpdatePendingRechargeOrderStatus(gomock.Any(), gomock.Any()).Return(errors.New("timeout"))
+	mockModel.EXPECT().FailJob(ctx, querier.FailJobParams{
+		ID:        2,
+		LastError: utils.Ptr("failed to mark recharge order expired: timeout"),
+		ClaimedAt: claimedAt,
+	}).Return(int64(1), nil)
+
+	// the job ran past the timeout and was rescued, the stale worker does not finalize it
+	mockModel.EXPECT().ClaimNextJob(ctx, gomock.Any()).Return(&querier.Job{
+		ID: 3, Kind: kind, Args: args, Attempts: 1, MaxAttempts: jobs.DefaultMaxAttempts, LockedAt: &claimedAt,
+	}, nil)
+	mockModel.EXPECT().UpdatePendingRechargeOrderStatus(gomock.Any(), gomock.Any()).Return(nil)
+	mockModel.EXPECT().CompleteJob(ctx, querier.CompleteJobParams{
+		ID:        3,
+		ClaimedAt: claimedAt,
+	}).Return(int64(0), nil)
+
+	// nothing is due
+	mockModel.EXPECT().ClaimNextJob(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
+
+	for _, expected := range []bool{true, true, true, true, false} {
+		ran, err := w.RunNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, ran)
+	}
+}
+
+type fakeLock struct{}
+
+func (fakeLock) Unlock() {}
+
+func TestRunScheduledTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx         = model.WithBypassRLS(context.Background())
+		now         = time.Date(2024, 3, 1, 0, 30, 5, 0, time.UTC)
+		scheduledAt = time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+	)
+
+	mockModel := model.NewExtendedMockModelInterface(ctrl)
+	svc := &Service{
+		m:   mockModel,
+		now: func() time.Time { return now },
+	}
+	sc, err := scheduler.NewScheduler(&config.Config{Scheduler: config.Scheduler{
+		Tasks: map[string]string{
+			TaskBilling:         scheduler.Off,
+			TaskPurgeAccounts:   "@hourly",
+			TaskPurgePhoneCodes: "*/30 * * * *",
+		},
+		Timezone: "Asia/Shanghai",
+	}}, mockModel)
+	require.NoError(t, err)
+	svc.RegisterScheduledTasks(sc)
+
+	// the task is run and recorded
+	mockModel.EXPECT().TryAdvisoryLock(ctx, "scheduled_task:"+TaskPurgePhoneCodes).Return(fakeLock{}, true, nil)
+	mockModel.EXPECT().IsScheduledTaskRun(model.WithPrimary(ctx), querier.IsScheduledTaskRunParams{
+		Task:        TaskPurgePhoneCodes,
+		ScheduledAt: scheduledAt,
+	}).Return(false, nil)
+	mockModel.EXPECT().PurgeExpiredPhoneCodes(ctx, now).Return(int64(3), nil)
+	mockModel.EXPECT().CreateScheduledTaskRun(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.CreateScheduledTaskRunParams) (*querier.ScheduledTaskRun, error) {
+			assert.Equal(t, TaskPurgePhoneCodes, arg.Task)
+			assert.Equal(t, scheduledAt, arg.ScheduledAt)
+			assert.Equal(t, scheduler.StatusSucceeded, arg.Status)
+			assert.Nil(t, arg.Error)
+			return &querier.ScheduledTaskRun{}, nil
+		},
+	)
+	mockModel.EXPECT().PurgeScheduledTaskRuns(ctx, gomock.Any()).Return(int64(0), nil)
+	require.NoError(t, sc.RunTask(ctx, TaskPurgePhoneCodes, scheduledAt))
+
+	// the failure is recorded
+	mockModel.EXPECT().TryAdvisoryLock(ctx, "scheduled_task:"+TaskPurgePhoneCodes).Return(fakeLock{}, true, nil)
+	mockModel.EXPECT().IsScheduledTaskRun(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockModel.EXPECT().PurgeExpiredPhoneCodes(ctx, now).Return(int64(0), errors.New("timeout"))
+	mockModel.EXPECT().CreateScheduledTaskRun(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg querier.CreateScheduledTaskRunParams) (*querier.ScheduledTaskRun, error) {
+			assert.Equal(t, scheduler.StatusFailed, arg.Status)
+			assert.Equal(t, "failed to purge expired phone codes: timeout", *arg.Error)
+			return &querier.ScheduledTaskRun{}, nil
+		},
+	)
+	mockModel.EXPECT().PurgeScheduledTaskRuns(ctx, gomock.Any()).Return(int64(0), nil)
+	require.NoError(t, sc.RunTask(ctx, TaskPurgePhoneCodes, scheduledAt))
+
+	// turned off in the config
+	assert.Error(t, sc.RunTask(ctx, TaskBilling, scheduledAt))
+}
+
+func TestHealthChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx       = context.Background()
+		mockModel = model.NewMockModelInterface(ctrl)
+		mockSMS   = sms.NewMockSMSManagerInterface(ctrl)
+	)
+
+	svc := &Service{
+		m:          mockModel,
+		smsManager: mockSMS,
+	}
+	r := health.NewRegistry()
+	svc.RegisterHealthChecks(r)
+
+	// all dependencies are ready
+	mockModel.EXPECT().Ping(gomock.Any()).Return(nil)
+	mockModel.EXPECT().GetMigrationStatus(gomock.Any()).Return(&model.MigrationStatus{Version: 15, Latest: 15}, nil)
+	mockSMS.EXPECT().Check(gomock.Any()).Return(nil)
+	report := r.Check(ctx)
+	assert.Equal(t, health.StatusOK, report.Status)
+	assert.Len(t, report.Checks, 3)
+
+	// pending migrations and an unreachable database
+	mockModel.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused"))
+	mockModel.EXPECT().GetMigrationStatus(gomock.Any()).Return(&model.MigrationStatus{Version: 13, Latest: 15}, nil)
+	mockSMS.EXPECT().Check(gomock.Any()).Return(nil)
+	report = r.Check(ctx)
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.CheckResult{Status: health.StatusFail, Error: "connection refused"}, report.Checks["postgres"])
+	assert.Equal(t, health.StatusFail, report.Checks["migrations"].Status)
+	assert.Contains(t, report.Checks["migrations"].Error, "2 pending migrations")
+	assert.Equal(t, health.StatusOK, report.Checks["sms"].Status)
+
+	// a check of a new subsystem
+	r.Register("cache", func(ctx context.Context) error { return nil })
+
+	// readiness fails during the shutdown even if all dependencies are ready
+	r.SetShuttingDown()
+	mockModel.EXPECT().Ping(gomock.Any()).Return(nil)
+	mockModel.EXPECT().GetMigrationStatus(gomock.Any()).Return(&model.MigrationStatus{Version: 15, Latest: 15}, nil)
+	mockSMS.EXPECT().Check(gomock.Any()).Return(nil)
+	report = r.Check(ctx)
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.StatusOK, report.Checks["cache"].Status)
+	assert.Equal(t, health.StatusFail, report.Checks["shutdown"].Status)
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/utils"
+)
+
+const StatementMonthLayout = "2006-01"
+
+const pgUniqueViolation = "23505"
+
+// invoiceNo formats the no-th invoice of the year, e.g. INV2024000001.
+func invoiceNo(year int, no int64) string {
+	return fmt.Sprintf("INV%d%06d", year, no)
+}
+
+// parseStatementMonth returns the natural month in China Standard Time, statements
+// can only be issued after the month has ended.
+func (s *Service) parseStatementMonth(month string) (time.Time, time.Time, error) {
+	t, err := time.ParseInLocation(StatementMonthLayout, month, cst)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidStatementMonth
+	}
+	start, end := currentPeriod(t)
+	if end.After(s.now()) {
+		return time.Time{}, time.Time{}, ErrStatementPeriodNotEnded
+	}
+	return start, end, nil
+}
+
+func statementToApi(statement *querier.OrgStatement) apigen.Statement {
+	return apigen.Statement{
+		Id:             statement.ID,
+		OrgId:          statement.OrgID,
+		Month:          statement.PeriodStart.In(cst).Format(StatementMonthLayout),
+		InvoiceNo:      statement.InvoiceNo,
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		CreatedAt:      statement.CreatedAt,
+	}
+}
+
+func (s *Service) ListStatements(ctx context.Context, orgID uuid.UUID) ([]apigen.Statement, error) {
+	statements, err := s.m.ListOrgStatements(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list org statements")
+	}
+	rtn := make([]apigen.Statement, 0, len(statements))
+	for _, statement := range statements {
+		rtn = append(rtn, statementToApi(statement))
+	}
+	return rtn, nil
+}
+
+// issueStatement creates the statement of the period and allocates its invoice number.
+// The invoice counter is locked until the transaction ends, so the number is released
+// if the statement is rolled back and invoice numbers never have gaps. The balances are
+// read from one snapshot, concurrent allocations fail with serialization failures and
+// are retried by RunTransaction.
+func (s *Service) issueStatement(ctx context.Context, orgID uuid.UUID, start, end time.Time) (*querier.OrgStatement, error) {
+	var rtn *querier.OrgStatement
+	err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		opening, err := model.GetOrgLedgerBalanceBefore(ctx, querier.GetOrgLedgerBalanceBeforeParams{
+			OrgID:  uuid.NullUUID{Valid: true, UUID: orgID},
+			Before: start,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get opening balance")
+		}
+		closing, err := model.GetOrgLedgerBalanceBefore(ctx, querier.GetOrgLedgerBalanceBeforeParams{
+			OrgID:  uuid.NullUUID{Valid: true, UUID: orgID},
+			Before: end,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get closing balance")
+		}
+		year := s.now().In(cst).Year()
+		no, err := model.NextInvoiceNo(ctx, int32(year))
+		if err != nil {
+			return errors.Wrap(err, "failed to allocate invoice number")
+		}
+		rtn, err = model.CreateOrgStatement(ctx, querier.CreateOrgStatementParams{
+			OrgID:          orgID,
+			PeriodStart:    start,
+			PeriodEnd:      end,
+			InvoiceNo:      invoiceNo(year, no),
+			OpeningBalance: opening,
+			ClosingBalance: closing,
+		})
+		return err
+	}, model.WithIsolationLevel(pgx.RepeatableRead), model.WithMaxRetries(DefaultMaxRetries))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			// issued concurrently by another request, which may not be replicated yet
+			return s.m.GetOrgStatement(model.WithPrimary(ctx), querier.GetOrgStatementParams{
+				OrgID:       orgID,
+				PeriodStart: start,
+			})
+		}
+		return nil, errors.Wrap(err, "failed to create org statement")
+	}
+	return rtn, nil
+}
+
+// GetStatement returns the statement of the month, it is issued on the first request
+// after the month has ended. Months ended before the org was created have no statement,
+// so that no invoice number is spent on them.
+func (s *Service) GetStatement(ctx context.Context, orgID uuid.UUID, month string) (*apigen.StatementDetail, error) {
+	start, end, err := s.parseStatementMonth(month)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := s.m.GetOrgStatement(ctx, querier.GetOrgStatementParams{
+		OrgID:       orgID,
+		PeriodStart: start,
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(err, "failed to get org statement")
+		}
+		org, err := s.m.GetOrgInfoByOrgId(ctx, orgID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrOrgNotFound
+			}
+			return nil, errors.Wrap(err, "failed to get org")
+		}
+		if !end.After(org.CreatedAt) {
+			return nil, ErrStatementBeforeOrg
+		}
+		statement, err = s.issueStatement(ctx, orgID, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summary, err := s.m.GetOrgLedgerSummary(ctx, querier.GetOrgLedgerSummaryParams{
+		OrgID:       uuid.NullUUID{Valid: true, UUID: orgID},
+		PeriodStart: statement.PeriodStart,
+		PeriodEnd:   statement.PeriodEnd,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get org ledger summary")
+	}
+	items := make([]apigen.StatementItem, 0, len(summary))
+	for _, row := range summary {
+		items = append(items, apigen.StatementItem{
+			Typ:    row.Typ,
+			Count:  row.Count,
+			Amount: row.Amount,
+		})
+	}
+	return &apigen.StatementDetail{
+		Statement: statementToApi(statement),
+		Items:     items,
+	}, nil
+}
+
+// formatCents formats cents as yuan with two decimals, e.g. -1234 -> -12.34
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func RenderStatementCSV(detail *apigen.StatementDetail) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{
+		{"invoice_no", detail.Statement.InvoiceNo},
+		{"month", detail.Statement.Month},
+		{"opening_balance", formatCents(detail.Statement.OpeningBalance)},
+		{"closing_balance", formatCents(detail.Statement.ClosingBalance)},
+		{},
+		{"typ", "count", "amount"},
+	}
+	for _, item := range detail.Items {
+		records = append(records, []string{item.Typ, strconv.FormatInt(item.Count, 10), formatCents(item.Amount)})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, errors.Wrap(err, "failed to write csv")
+	}
+	return buf.Bytes(), nil
+}
+
+func RenderStatementPDF(detail *apigen.StatementDetail) []byte {
+	lines := []string{
+		fmt.Sprintf("Statement %s", detail.Statement.Month),
+		fmt.Sprintf("Invoice No: %s", detail.Statement.InvoiceNo),
+		fmt.Sprintf("Org: %s", detail.Statement.OrgId),
+		fmt.Sprintf("Issued At: %s", detail.Statement.CreatedAt.In(cst).Format(time.DateTime)),
+		"",
+		fmt.Sprintf("Opening Balance: %s", formatCents(detail.Statement.OpeningBalance)),
+		"",
+		fmt.Sprintf("%-20s %10s %16s", "Type", "Count", "Amount"),
+	}
+	for _, item := range detail.Items {
+		lines = append(lines, fmt.Sprintf("%-20s %10d %16s", item.Typ, item.Count, formatCents(item.Amount)))
+	}
+	lines = append(lines, "", fmt.Sprintf("Closing Balance: %s", formatCents(detail.Statement.ClosingBalance)))
+	return utils.RenderTextPDF(lines)
+}
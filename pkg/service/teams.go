@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+func teamToApi(team *querier.Team) apigen.Team {
+	return apigen.Team{
+		Id:        team.ID,
+		OrgId:     team.OrgID,
+		Name:      team.Name,
+		CreatedAt: team.CreatedAt,
+		Version:   team.Version,
+	}
+}
+
+// getTeam returns ErrTeamNotFound if the team does not exist in the org.
+func getTeam(ctx context.Context, m model.ModelInterface, orgID uuid.UUID, teamID uuid.UUID) (*querier.Team, error) {
+	team, err := m.GetTeam(ctx, querier.GetTeamParams{
+		ID:    teamID,
+		OrgID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get team")
+	}
+	return team, nil
+}
+
+func (s *Service) ListTeams(ctx context.Context, orgID uuid.UUID) ([]apigen.Team, error) {
+	teams, err := s.m.ListOrgTeams(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list org teams")
+	}
+	rtn := make([]apigen.Team, 0, len(teams))
+	for _, team := range teams {
+		rtn = append(rtn, teamToApi(team))
+	}
+	return rtn, nil
+}
+
+func (s *Service) CreateTeam(ctx context.Context, orgID uuid.UUID, name string) (*apigen.Team, error) {
+	var rtn apigen.Team
+	if err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		exist, err := model.IsTeamNameExist(ctx, querier.IsTeamNameExistParams{
+			OrgID: orgID,
+			Name:  name,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to check team name exist")
+		}
+		if exist {
+			return ErrTeamAlreadyExist
+		}
+		team, err := model.CreateTeam(ctx, querier.CreateTeamParams{
+			OrgID: orgID,
+			Name:  name,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create team")
+		}
+		rtn = teamToApi(team)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &rtn, nil
+}
+
+func (s *Service) GetTeamDetail(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID) (*apigen.TeamDetail, error) {
+	team, err := getTeam(ctx, s.m, orgID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.m.GetTeamMembers(ctx, team.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get team members")
+	}
+	rules, err := s.m.GetTeamAccessRuleNames(ctx, team.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get team access rules")
+	}
+
+	rtn := &apigen.TeamDetail{
+		Team:    teamToApi(team),
+		Members: make([]apigen.TeamMember, 0, len(members)),
+		Rules:   make([]string, 0, len(rules)),
+	}
+	for _, member := range members {
+		rtn.Members = append(rtn.Members, apigen.TeamMember{
+			Id:       member.ID,
+			Username: member.Name,
+			Phone:    member.Phone,
+		})
+	}
+	rtn.Rules = append(rtn.Rules, rules...)
+	return rtn, nil
+}
+
+// RenameTeam renames the team, ErrVersionConflict is returned if the version is given
+// and the team has been modified since.
+func (s *Service) RenameTeam(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, name string, version *int32) (*apigen.Team, error) {
+	var rtn apigen.Team
+	if err := s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		team, err := getTeam(ctx, model, orgID, teamID)
+		if err != nil {
+			return err
+		}
+		if version != nil && team.Version != *version {
+			return ErrVersionConflict
+		}
+		if team.Name != name {
+			exist, err := model.IsTeamNameExist(ctx, querier.IsTeamNameExistParams{
+				OrgID: orgID,
+				Name:  name,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to check team name exist")
+			}
+			if exist {
+				return ErrTeamAlreadyExist
+			}
+		}
+		team, err = model.UpdateTeamName(ctx, querier.UpdateTeamNameParams{
+			ID:      teamID,
+			OrgID:   orgID,
+			Name:    name,
+			Version: version,
+		})
+		if err != nil {
+			// the team is modified concurrently after it is read
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrVersionConflict
+			}
+			return errors.Wrap(err, "failed to update team name")
+		}
+		rtn = teamToApi(team)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &rtn, nil
+}
+
+// DeleteTeam deletes the team, ErrVersionConflict is returned if the version is given
+// and the team has been modified since.
+func (s *Service) DeleteTeam(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, version *int32) error {
+	team, err := getTeam(ctx, s.m, orgID, teamID)
+	if err != nil {
+		return err
+	}
+	if version != nil && team.Version != *version {
+		return ErrVersionConflict
+	}
+	deleted, err := s.m.DeleteTeam(ctx, querier.DeleteTeamParams{
+		ID:      teamID,
+		OrgID:   orgID,
+		Version: version,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete team")
+	}
+	// the team is modified concurrently after it is read
+	if deleted == 0 && version != nil {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// AddTeamMember adds the user to the team, the user must belong to the same org as the team.
+func (s *Service) AddTeamMember(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, userID uuid.UUID) error {
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		team, err := getTeam(ctx, model, orgID, teamID)
+		if err != nil {
+			return err
+		}
+		user, err := model.GetUserByID(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotInOrg
+			}
+			return errors.Wrap(err, "failed to get user")
+		}
+		if user.OrgID != team.OrgID {
+			return ErrUserNotInOrg
+		}
+		if err := model.AddTeamMember(ctx, querier.AddTeamMemberParams{
+			TeamID: team.ID,
+			UserID: user.ID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to add team member")
+		}
+		return nil
+	})
+}
+
+func (s *Service) RemoveTeamMember(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, userID uuid.UUID) error {
+	team, err := getTeam(ctx, s.m, orgID, teamID)
+	if err != nil {
+		return err
+	}
+	if err := s.m.RemoveTeamMember(ctx, querier.RemoveTeamMemberParams{
+		TeamID: team.ID,
+		UserID: userID,
+	}); err != nil {
+		return errors.Wrap(err, "failed to remove team member")
+	}
+	return nil
+}
+
+// GrantTeamAccessRule grants the access rule to the team, all members of the team
+// will have the rule in their claims after their next login. The platform rules in
+// model.AllRules are granted by the platform only, as every user owns an org and
+// could otherwise grant them to themselves.
+func (s *Service) GrantTeamAccessRule(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, ruleName string) error {
+	if slices.Contains(model.AllRules, ruleName) {
+		return ErrReservedAccessRule
+	}
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		team, err := getTeam(ctx, model, orgID, teamID)
+		if err != nil {
+			return err
+		}
+		rule, err := model.GetAccessRule(ctx, ruleName)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrAccessRuleNotFound
+			}
+			return errors.Wrap(err, "failed to get access rule")
+		}
+		if err := model.AddTeamAccessRule(ctx, querier.AddTeamAccessRuleParams{
+			TeamID: team.ID,
+			RuleID: rule.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add access rule %s to team %s", ruleName, team.ID)
+		}
+		return nil
+	})
+}
+
+func (s *Service) RevokeTeamAccessRule(ctx context.Context, orgID uuid.UUID, teamID uuid.UUID, ruleName string) error {
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		team, err := getTeam(ctx, model, orgID, teamID)
+		if err != nil {
+			return err
+		}
+		rule, err := model.GetAccessRule(ctx, ruleName)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrAccessRuleNotFound
+			}
+			return errors.Wrap(err, "failed to get access rule")
+		}
+		if err := model.RemoveTeamAccessRule(ctx, querier.RemoveTeamAccessRuleParams{
+			TeamID: team.ID,
+			RuleID: rule.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove access rule %s from team %s", ruleName, team.ID)
+		}
+		return nil
+	})
+}
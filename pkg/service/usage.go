@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+)
+
+const (
+	UsageMetricSms     = "sms"
+	UsageMetricApiCall = "api_call"
+)
+
+var AllUsageMetrics = []string{
+	UsageMetricSms,
+	UsageMetricApiCall,
+}
+
+// usage is aggregated by the day in China Standard Time
+var cst = time.FixedZone("CST", 8*60*60)
+
+// today returns the day of now in China Standard Time, the day is not left to the
+// database as it follows the time zone of the session.
+func (s *Service) today() pgtype.Date {
+	return pgtype.Date{Time: s.now().In(cst), Valid: true}
+}
+
+// currentPeriod returns the natural month containing t, it is the billing period of the free plan.
+func currentPeriod(t time.Time) (time.Time, time.Time) {
+	t = t.In(cst)
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, cst)
+	return start, start.AddDate(0, 1, 0)
+}
+
+func (s *Service) recordUsage(ctx context.Context, orgID uuid.UUID, metric string) error {
+	if err := s.m.IncreaseUsage(ctx, querier.IncreaseUsageParams{
+		OrgID:  orgID,
+		Metric: metric,
+		Day:    s.today(),
+		Count:  1,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to record %s usage of org %s", metric, orgID)
+	}
+	return nil
+}
+
+// ConsumeQuota records one usage of the metric, false is returned without recording
+// if the org has used up its quota. The check and the record are done in one update
+// which locks the usage of the day, so concurrent requests cannot exceed the quota.
+func (s *Service) ConsumeQuota(ctx context.Context, orgID uuid.UUID, metric string) (bool, error) {
+	plan, start, _, err := s.getOrgPlan(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	limit := s.planQuota(plan, metric)
+	if limit <= 0 {
+		return true, s.recordUsage(ctx, orgID, metric)
+	}
+	today := s.today()
+	if err := s.m.InitUsage(ctx, querier.InitUsageParams{
+		OrgID:  orgID,
+		Metric: metric,
+		Day:    today,
+	}); err != nil {
+		return false, errors.Wrapf(err, "failed to init %s usage of org %s", metric, orgID)
+	}
+	consumed, err := s.m.ConsumeUsage(ctx, querier.ConsumeUsageParams{
+		OrgID:  orgID,
+		Metric: metric,
+		Day:    today,
+		Since:  pgtype.Date{Time: start.In(cst), Valid: true},
+		Quota:  limit,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to consume %s quota of org %s", metric, orgID)
+	}
+	return consumed == 1, nil
+}
+
+// GetOrgUsage returns the usage of the org in the current period along with the limits of its plan.
+func (s *Service) GetOrgUsage(ctx context.Context, orgID uuid.UUID) (*apigen.OrgUsage, error) {
+	plan, start, end, err := s.getOrgPlan(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.m.GetOrgUsage(ctx, querier.GetOrgUsageParams{
+		OrgID: orgID,
+		Since: pgtype.Date{Time: start.In(cst), Valid: true},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get org usage")
+	}
+	used := make(map[string]int64)
+	for _, row := range rows {
+		used[row.Metric] = row.Count
+	}
+
+	rtn := &apigen.OrgUsage{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Items:       make([]apigen.UsageItem, 0, len(AllUsageMetrics)),
+	}
+	for _, metric := range AllUsageMetrics {
+		rtn.Items = append(rtn.Items, apigen.UsageItem{
+			Metric: metric,
+			Used:   used[metric],
+			Limit:  s.planQuota(plan, metric),
+		})
+	}
+	return rtn, nil
+}
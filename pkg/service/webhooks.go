@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/apigen"
+	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/model/querier"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/utils"
+	"github.com/xich-dev/go-starter/pkg/webhook"
+)
+
+// Events the webhooks can subscribe to, the ping event is only sent by TestWebhook.
+const (
+	WebhookEventMemberJoined   = "member.joined"
+	WebhookEventBalanceChanged = "balance.changed"
+	WebhookEventPing           = "ping"
+)
+
+var webhookEvents = []string{WebhookEventMemberJoined, WebhookEventBalanceChanged}
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// webhookBody is the body posted to the endpoints, the id is the same for every attempt
+// of a delivery so that the receivers can drop duplicates.
+type webhookBody struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type MemberJoinedWebhook struct {
+	UserID   uuid.UUID `json:"userId"`
+	OrgID    uuid.UUID `json:"orgId"`
+	Username string    `json:"username"`
+}
+
+type PingWebhook struct {
+	WebhookID uuid.UUID `json:"webhookId"`
+}
+
+func webhookToApi(e *querier.WebhookEndpoint) apigen.Webhook {
+	return apigen.Webhook{
+		Id:        e.ID,
+		OrgId:     e.OrgID,
+		Url:       e.Url,
+		Events:    e.Events,
+		Enabled:   e.Enabled,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func webhookDeliveryToApi(d *querier.WebhookDelivery) (apigen.WebhookDelivery, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(d.Payload, &payload); err != nil {
+		return apigen.WebhookDelivery{}, errors.Wrap(err, "failed to unmarshal webhook payload")
+	}
+	return apigen.WebhookDelivery{
+		Id:           d.ID,
+		WebhookId:    d.EndpointID,
+		Event:        d.Event,
+		Payload:      payload,
+		Status:       d.Status,
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		LastError:    d.LastError,
+		DeliveredAt:  d.DeliveredAt,
+		CreatedAt:    d.CreatedAt,
+	}, nil
+}
+
+// validateWebhook checks the url and the events of the webhook, the deduplicated events
+// are returned. The host of the url must resolve to public addresses only.
+func (s *Service) validateWebhook(ctx context.Context, rawURL string, events []string) ([]string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Hostname()) == 0 {
+		return nil, ErrInvalidWebhookURL
+	}
+	if len(events) == 0 {
+		return nil, ErrInvalidWebhookEvent
+	}
+	for _, event := range events {
+		if !slices.Contains(webhookEvents, event) {
+			return nil, errors.Wrap(ErrInvalidWebhookEvent, event)
+		}
+	}
+	if err := s.webhook.CheckHost(ctx, u.Hostname()); err != nil {
+		// the resolver errors are not told as they may describe the internal network
+		log.Infof("rejected webhook host %s: %s", u.Hostname(), err.Error())
+		return nil, ErrWebhookHostNotAllowed
+	}
+	events = slices.Clone(events)
+	slices.Sort(events)
+	return slices.Compact(events), nil
+}
+
+// getWebhookEndpoint returns ErrWebhookNotFound if the webhook does not exist in the org.
+func getWebhookEndpoint(ctx context.Context, m model.ModelInterface, orgID uuid.UUID, webhookID uuid.UUID) (*querier.WebhookEndpoint, error) {
+	endpoint, err := m.GetWebhookEndpoint(ctx, querier.GetWebhookEndpointParams{
+		ID:    webhookID,
+		OrgID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get webhook")
+	}
+	return endpoint, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, orgID uuid.UUID) ([]apigen.Webhook, error) {
+	endpoints, err := s.m.ListWebhookEndpoints(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list webhooks")
+	}
+	rtn := make([]apigen.Webhook, 0, len(endpoints))
+	for _, e := range endpoints {
+		rtn = append(rtn, webhookToApi(e))
+	}
+	return rtn, nil
+}
+
+// CreateWebhook registers the endpoint with a new signing secret, the secret is only
+// returned here.
+func (s *Service) CreateWebhook(ctx context.Context, orgID uuid.UUID, rawURL string, events []string) (*apigen.Webhook, error) {
+	events, err := s.validateWebhook(ctx, rawURL, events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.m.CreateWebhookEndpoint(ctx, querier.CreateWebhookEndpointParams{
+		OrgID:  orgID,
+		Url:    rawURL,
+		Secret: secret,
+		Events: events,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook")
+	}
+	rtn := webhookToApi(endpoint)
+	rtn.Secret = &endpoint.Secret
+	return &rtn, nil
+}
+
+func (s *Service) GetWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) (*apigen.Webhook, error) {
+	endpoint, err := getWebhookEndpoint(ctx, s.m, orgID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	rtn := webhookToApi(endpoint)
+	return &rtn, nil
+}
+
+func (s *Service) UpdateWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID, rawURL string, events []string, enabled bool) (*apigen.Webhook, error) {
+	events, err := s.validateWebhook(ctx, rawURL, events)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.m.UpdateWebhookEndpoint(ctx, querier.UpdateWebhookEndpointParams{
+		ID:      webhookID,
+		OrgID:   orgID,
+		Url:     rawURL,
+		Events:  events,
+		Enabled: enabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, errors.Wrap(err, "failed to update webhook")
+	}
+	rtn := webhookToApi(endpoint)
+	return &rtn, nil
+}
+
+// DeleteWebhook deletes the webhook along with its delivery log, pending deliveries are
+// dropped.
+func (s *Service) DeleteWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) error {
+	deleted, err := s.m.DeleteWebhookEndpoint(ctx, querier.DeleteWebhookEndpointParams{
+		ID:    webhookID,
+		OrgID: orgID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete webhook")
+	}
+	if deleted == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID, page PageParams) (*apigen.WebhookDeliveryPage, error) {
+	if _, err := getWebhookEndpoint(ctx, s.m, orgID, webhookID); err != nil {
+		return nil, err
+	}
+	cursorCreatedAt, cursorID := page.cursorArgs()
+	deliveries, err := s.m.ListWebhookDeliveries(ctx, querier.ListWebhookDeliveriesParams{
+		EndpointID:      webhookID,
+		CursorCreatedAt: cursorCreatedAt,
+		Descending:      page.Descending,
+		CursorID:        cursorID,
+		RowLimit:        page.Limit + 1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list webhook deliveries")
+	}
+	deliveries, next := paginate(deliveries, page.Limit, func(d *querier.WebhookDelivery) utils.Cursor {
+		return utils.Cursor{CreatedAt: d.CreatedAt, ID: d.ID}
+	})
+	rtn := &apigen.WebhookDeliveryPage{
+		Items:      make([]apigen.WebhookDelivery, 0, len(deliveries)),
+		NextCursor: next,
+	}
+	for _, d := range deliveries {
+		item, err := webhookDeliveryToApi(d)
+		if err != nil {
+			return nil, err
+		}
+		rtn.Items = append(rtn.Items, item)
+	}
+	return rtn, nil
+}
+
+// TestWebhook sends a ping event to the webhook right away and returns the delivery,
+// a failed delivery is not retried.
+func (s *Service) TestWebhook(ctx context.Context, orgID uuid.UUID, webhookID uuid.UUID) (*apigen.WebhookDelivery, error) {
+	endpoint, err := getWebhookEndpoint(ctx, s.m, orgID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(PingWebhook{WebhookID: endpoint.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal ping")
+	}
+	delivery, err := s.m.CreateWebhookDelivery(ctx, querier.CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		Event:      WebhookEventPing,
+		Payload:    payload,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+	delivery, err = s.sendWebhook(ctx, endpoint, delivery)
+	if delivery == nil {
+		return nil, err
+	}
+	rtn, err := webhookDeliveryToApi(delivery)
+	if err != nil {
+		return nil, err
+	}
+	return &rtn, nil
+}
+
+// enqueueWebhooks creates a delivery of the event for each enabled webhook of the org
+// subscribed to it. The outbox event is recorded in the deliveries, so enqueueing the
+// same outbox event again is a no-op.
+func (s *Service) enqueueWebhooks(ctx context.Context, outboxEventID int64, orgID uuid.UUID, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook payload")
+	}
+	return s.m.RunTransaction(ctx, func(model model.ModelInterface) error {
+		endpoints, err := model.ListWebhookEndpointsByEvent(ctx, querier.ListWebhookEndpointsByEventParams{
+			OrgID: orgID,
+			Event: event,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list webhooks")
+		}
+		for _, endpoint := range endpoints {
+			delivery, err := model.CreateWebhookDelivery(ctx, querier.CreateWebhookDeliveryParams{
+				EndpointID:    endpoint.ID,
+				Event:         event,
+				Payload:       payload,
+				OutboxEventID: &outboxEventID,
+			})
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					continue
+				}
+				return errors.Wrap(err, "failed to create webhook delivery")
+			}
+			if err := outbox.Publish(ctx, model, EventWebhookDelivery, WebhookDeliveryEvent{
+				DeliveryID: delivery.ID,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sendWebhook posts the delivery to the endpoint and records the result in the delivery
+// log. The updated delivery is returned along with the error of the attempt.
+func (s *Service) sendWebhook(ctx context.Context, endpoint *querier.WebhookEndpoint, delivery *querier.WebhookDelivery) (*querier.WebhookDelivery, error) {
+	body, err := json.Marshal(webhookBody{
+		ID:        delivery.ID,
+		Type:      delivery.Event,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook body")
+	}
+	code, sendErr := s.webhook.Send(ctx, &webhook.Delivery{
+		ID:     delivery.ID.String(),
+		Event:  delivery.Event,
+		URL:    endpoint.Url,
+		Secret: endpoint.Secret,
+		Body:   body,
+	})
+
+	params := querier.UpdateWebhookDeliveryResultParams{
+		ID:     delivery.ID,
+		Status: WebhookDeliveryStatusSucceeded,
+	}
+	if code != 0 {
+		params.ResponseCode = utils.Ptr(int32(code))
+	}
+	if sendErr != nil {
+		params.Status = WebhookDeliveryStatusFailed
+		params.LastError = utils.Ptr(sendErr.Error())
+	}
+	delivery, err = s.m.UpdateWebhookDeliveryResult(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update webhook delivery")
+	}
+	return delivery, sendErr
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/webhook/webhook.go
+
+// Package webhook is a generated GoMock package.
+package webhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSenderInterface is a mock of SenderInterface interface.
+type MockSenderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSenderInterfaceMockRecorder
+}
+
+// MockSenderInterfaceMockRecorder is the mock recorder for MockSenderInterface.
+type MockSenderInterfaceMockRecorder struct {
+	mock *MockSenderInterface
+}
+
+// NewMockSenderInterface creates a new mock instance.
+func NewMockSenderInterface(ctrl *gomock.Controller) *MockSenderInterface {
+	mock := &MockSenderInterface{ctrl: ctrl}
+	mock.recorder = &MockSenderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSenderInterface) EXPECT() *MockSenderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckHost mocks base method.
+func (m *MockSenderInterface) CheckHost(ctx context.Context, host string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHost", ctx, host)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHost indicates an expected call of CheckHost.
+func (mr *MockSenderInterfaceMockRecorder) CheckHost(ctx, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHost", reflect.TypeOf((*MockSenderInterface)(nil).CheckHost), ctx, host)
+}
+
+// Send mocks base method.
+func (m *MockSenderInterface) Send(ctx context.Context, d *Delivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, d)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSenderInterfaceMockRecorder) Send(ctx, d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSenderInterface)(nil).Send), ctx, d)
+}
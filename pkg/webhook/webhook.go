@@ -0,0 +1,230 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+// Headers of the deliveries, receivers verify the signature with Verify.
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	httpTimeout = 10 * time.Second
+
+	signaturePrefix = "v1="
+	secretPrefix    = "whsec_"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrAddressNotAllowed is returned for the endpoints on loopback, private and other
+	// non-public addresses, so that the webhooks cannot reach the internal network.
+	ErrAddressNotAllowed = errors.New("address is not allowed")
+)
+
+// nonPublicPrefixes are the special purpose ranges not covered by the netip.Addr
+// methods checked in IsPublicAddr.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// IsPublicAddr reports whether the address is routable on the internet.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Delivery is a request to the endpoint of a webhook.
+type Delivery struct {
+	ID     string
+	Event  string
+	URL    string
+	Secret string
+	Body   []byte
+}
+
+type SenderInterface interface {
+	// Send posts the signed body to the endpoint and returns the status code of the
+	// response. An error is returned if the request fails or the status is not 2xx, in
+	// the latter case the status code is returned as well. Redirects are not followed
+	// and the response body is not read.
+	Send(ctx context.Context, d *Delivery) (int, error)
+
+	// CheckHost resolves the host of an endpoint, ErrAddressNotAllowed is returned if
+	// any of its addresses is not allowed.
+	CheckHost(ctx context.Context, host string) error
+}
+
+func NewSender(cfg *config.Config) SenderInterface {
+	s := &Sender{
+		allowPrivate: cfg.Webhook.AllowPrivateNetwork,
+		resolver:     net.DefaultResolver,
+		now:          time.Now,
+	}
+	dialer := &net.Dialer{
+		Timeout: httpTimeout,
+		// the address is checked after it is resolved, so that a host resolving to a
+		// public address at validation cannot be pointed at the internal network later
+		Control: s.control,
+	}
+	s.httpClient = &http.Client{
+		Timeout: httpTimeout,
+		Transport: &http.Transport{
+			// no proxy, the address dialed must be the one of the endpoint
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: httpTimeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+type Sender struct {
+	httpClient   *http.Client
+	allowPrivate bool
+	resolver     *net.Resolver
+	now          func() time.Time
+}
+
+func (s *Sender) allowed(addr netip.Addr) bool {
+	return s.allowPrivate || IsPublicAddr(addr)
+}
+
+// control rejects the connections to the addresses which are not allowed.
+func (s *Sender) control(network, address string, c syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse address %s", address)
+	}
+	if !s.allowed(addrPort.Addr()) {
+		return ErrAddressNotAllowed
+	}
+	return nil
+}
+
+func (s *Sender) CheckHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !s.allowed(addr) {
+			return ErrAddressNotAllowed
+		}
+		return nil
+	}
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve %s", host)
+	}
+	for _, addr := range addrs {
+		if !s.allowed(addr) {
+			return ErrAddressNotAllowed
+		}
+	}
+	return nil
+}
+
+func (s *Sender) Send(ctx context.Context, d *Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create request")
+	}
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, d.ID)
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(d.Secret, timestamp, d.Body))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		// the error names the address the host resolved to, which is not told
+		if errors.Is(err, ErrAddressNotAllowed) {
+			return 0, ErrAddressNotAllowed
+		}
+		return 0, errors.Wrap(err, "failed to send request")
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// Sign returns the signature of the delivery, which is the hex encoded HMAC-SHA256 of
+// the timestamp and the body joined by a dot.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the delivery received at now, deliveries whose
+// timestamp is off by more than tolerance are rejected to prevent replays.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp := header.Get(HeaderTimestamp)
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, "invalid timestamp")
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+		return errors.Wrap(ErrInvalidSignature, "timestamp out of tolerance")
+	}
+	if !hmac.Equal([]byte(header.Get(HeaderSignature)), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// GenerateSecret returns a random signing secret for a new endpoint.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate secret")
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
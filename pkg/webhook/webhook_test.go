@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xich-dev/go-starter/pkg/config"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	testCases := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "8.8.8.8", expected: true},
+		{addr: "1.1.1.1", expected: true},
+		{addr: "2606:4700:4700::1111", expected: true},
+
+		{addr: "127.0.0.1", expected: false},
+		{addr: "::1", expected: false},
+		{addr: "0.0.0.0", expected: false},
+		{addr: "::", expected: false},
+		{addr: "10.1.2.3", expected: false},
+		{addr: "172.16.0.1", expected: false},
+		{addr: "192.168.1.1", expected: false},
+		{addr: "169.254.169.254", expected: false},
+		{addr: "100.100.100.200", expected: false},
+		{addr: "224.0.0.1", expected: false},
+		{addr: "255.255.255.255", expected: false},
+		{addr: "fc00::1", expected: false},
+		{addr: "fe80::1", expected: false},
+		{addr: "::ffff:127.0.0.1", expected: false},
+		{addr: "::ffff:10.0.0.1", expected: false},
+		{addr: "64:ff9b::a00:1", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.addr, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, IsPublicAddr(netip.MustParseAddr(testCase.addr)))
+		})
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	ctx := context.Background()
+	s := NewSender(&config.Config{})
+
+	assert.NoError(t, s.CheckHost(ctx, "8.8.8.8"))
+	assert.ErrorIs(t, s.CheckHost(ctx, "127.0.0.1"), ErrAddressNotAllowed)
+	assert.ErrorIs(t, s.CheckHost(ctx, "::1"), ErrAddressNotAllowed)
+	assert.ErrorIs(t, s.CheckHost(ctx, "localhost"), ErrAddressNotAllowed)
+
+	s = NewSender(&config.Config{Webhook: config.Webhook{AllowPrivateNetwork: true}})
+	assert.NoError(t, s.CheckHost(ctx, "127.0.0.1"))
+}
+
+func TestSendRejectsPrivateAddress(t *testing.T) {
+	var received bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	code, err := NewSender(&config.Config{}).Send(context.Background(), &Delivery{
+		ID:     "1",
+		Event:  "ping",
+		URL:    receiver.URL,
+		Secret: "whsec_test",
+		Body:   []byte(`{}`),
+	})
+	require.ErrorIs(t, err, ErrAddressNotAllowed)
+	assert.Equal(t, ErrAddressNotAllowed.Error(), err.Error())
+	assert.Zero(t, code)
+	assert.False(t, received)
+}
+
+func TestSendDoesNotFollowRedirects(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	code, err := NewSender(&config.Config{Webhook: config.Webhook{AllowPrivateNetwork: true}}).Send(context.Background(), &Delivery{
+		ID:     "1",
+		Event:  "ping",
+		URL:    receiver.URL,
+		Secret: "whsec_test",
+		Body:   []byte(`{}`),
+	})
+	require.EqualError(t, err, "unexpected status 307")
+	assert.Equal(t, http.StatusTemporaryRedirect, code)
+	assert.False(t, redirected)
+}
@@ -0,0 +1,5 @@
+-- the app connects as a role which is neither a superuser nor allowed to bypass the
+-- row-level security, so that the policies of the org tables apply to it. It owns its
+-- database to run the migrations, the policies are forced on the table owner as well.
+CREATE ROLE app LOGIN PASSWORD 'app' NOSUPERUSER NOBYPASSRLS;
+CREATE DATABASE app OWNER app;
@@ -1,16 +1,24 @@
 #!/bin/bash 
 set +e
 
+SCRIPT_DIR=$(cd "$(dirname "$0")" && pwd)
+
 docker stop ddease-lite-testpg
-docker run --rm -d -p 5432:5432 -e POSTGRES_USER=postgres -e POSTGRES_PASSWORD=postgres -e POSTGRES_DB=postgres --name ddease-lite-testpg postgres:14.1-alpine
+# the tests connect as the app role created by init-db.sql, which is subject to the
+# row-level security unlike the postgres superuser
+docker run --rm -d -p 5432:5432 -e POSTGRES_USER=postgres -e POSTGRES_PASSWORD=postgres -e POSTGRES_DB=postgres \
+    -v "$SCRIPT_DIR/init-db.sql:/docker-entrypoint-initdb.d/init-db.sql:ro" \
+    --name ddease-lite-testpg postgres:14.1-alpine
 
 export XICFG_PG_HOST=localhost
 export XICFG_PG_PORT=5432
-export XICFG_PG_USER=postgres
-export XICFG_PG_PASSWORD=postgres
-export XICFG_PG_DB=postgres
+export XICFG_PG_USER=app
+export XICFG_PG_PASSWORD=app
+export XICFG_PG_DB=app
 export XICFG_PG_MIGRATION=../sql/migrations
 export XICFG_JWT_SECRET=jwt_secret
+export XICFG_FAKEPAYMENT=true
+export XICFG_FAKEDINGTALK=true
 export XICFG_YUNMA_TOKEN=yunma_token
 export XICFG_disableratelimiter=true
 
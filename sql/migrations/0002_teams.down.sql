@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_access_rules;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+
+COMMIT;
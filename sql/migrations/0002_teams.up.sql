@@ -0,0 +1,36 @@
+BEGIN;
+
+CREATE TABLE teams (
+    id          UUID        DEFAULT gen_random_uuid(),
+    org_id      UUID        NOT NULL,
+    name        TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (org_id, name),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE team_members (
+    team_id     UUID        NOT NULL,
+    user_id     UUID        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (team_id, user_id),
+    FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX team_members_user_id_idx ON team_members (user_id);
+
+CREATE TABLE team_access_rules (
+    team_id     UUID NOT NULL,
+    rule_id     UUID NOT NULL,
+
+    PRIMARY KEY (team_id, rule_id),
+    FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (rule_id) REFERENCES access_rules (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+COMMIT;
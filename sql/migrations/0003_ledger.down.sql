@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP TABLE IF EXISTS org_balances;
+DROP FUNCTION IF EXISTS ledger_check_balanced;
+DROP FUNCTION IF EXISTS ledger_forbid_modification;
+
+COMMIT;
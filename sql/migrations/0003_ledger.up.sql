@@ -0,0 +1,79 @@
+BEGIN;
+
+-- cached balance of each org, always updated together with the ledger
+-- while holding the row lock.
+CREATE TABLE org_balances (
+    org_id      UUID        NOT NULL,
+    balance     BIGINT      NOT NULL DEFAULT 0,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (org_id),
+    CHECK (balance >= 0),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE ledger_transactions (
+    id          UUID        DEFAULT gen_random_uuid(),
+    org_id      UUID        NOT NULL,
+    typ         VARCHAR(32) NOT NULL,
+    amount      BIGINT      NOT NULL,
+    description TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    CHECK (amount > 0),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON UPDATE CASCADE
+);
+
+CREATE INDEX ledger_transactions_org_id_created_at_idx ON ledger_transactions (org_id, created_at);
+
+CREATE TABLE ledger_entries (
+    id              BIGSERIAL,
+    transaction_id  UUID        NOT NULL,
+    account         VARCHAR(64) NOT NULL,
+    org_id          UUID,
+    amount          BIGINT      NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    FOREIGN KEY (transaction_id) REFERENCES ledger_transactions (id),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON UPDATE CASCADE
+);
+
+CREATE INDEX ledger_entries_transaction_id_idx ON ledger_entries (transaction_id);
+CREATE INDEX ledger_entries_org_id_idx ON ledger_entries (org_id);
+
+-- the ledger is append-only
+CREATE FUNCTION ledger_forbid_modification() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger is append-only, % on % is not allowed', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_transactions_append_only
+    BEFORE UPDATE OR DELETE ON ledger_transactions
+    FOR EACH ROW EXECUTE FUNCTION ledger_forbid_modification();
+
+CREATE TRIGGER ledger_entries_append_only
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_forbid_modification();
+
+-- entries of a ledger transaction must sum up to zero when the database transaction commits
+CREATE FUNCTION ledger_check_balanced() RETURNS TRIGGER AS $$
+DECLARE
+    total BIGINT;
+BEGIN
+    SELECT SUM(amount) INTO total FROM ledger_entries WHERE transaction_id = NEW.transaction_id;
+    IF total <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced, total: %', NEW.transaction_id, total;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_balanced();
+
+COMMIT;
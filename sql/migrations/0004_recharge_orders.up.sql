@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE recharge_orders (
+    id                      UUID        DEFAULT gen_random_uuid(),
+    org_id                  UUID        NOT NULL,
+    user_id                 UUID        NOT NULL,
+    provider                VARCHAR(32) NOT NULL,
+    amount                  BIGINT      NOT NULL,
+    status                  VARCHAR(16) NOT NULL DEFAULT 'pending',
+    provider_trade_id       TEXT,
+    ledger_transaction_id   UUID,
+    expired_at              TIMESTAMPTZ NOT NULL,
+    paid_at                 TIMESTAMPTZ,
+    created_at              TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at              TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    CHECK (amount > 0),
+    UNIQUE (provider, provider_trade_id),
+    UNIQUE (ledger_transaction_id),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON UPDATE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE,
+    FOREIGN KEY (ledger_transaction_id) REFERENCES ledger_transactions (id)
+);
+
+CREATE INDEX recharge_orders_org_id_created_at_idx ON recharge_orders (org_id, created_at);
+
+COMMIT;
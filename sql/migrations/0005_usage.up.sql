@@ -0,0 +1,15 @@
+BEGIN;
+
+-- billable usage of each org aggregated per day
+CREATE TABLE usage_daily (
+    org_id      UUID        NOT NULL,
+    metric      VARCHAR(32) NOT NULL,
+    day         DATE        NOT NULL,
+    count       BIGINT      NOT NULL DEFAULT 0,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (org_id, metric, day),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- paid plan subscriptions, orgs without a subscription are on the free plan
+CREATE TABLE org_subscriptions (
+    org_id                  UUID        NOT NULL,
+    plan                    VARCHAR(32) NOT NULL,
+    current_period_start    TIMESTAMPTZ NOT NULL,
+    current_period_end      TIMESTAMPTZ NOT NULL,
+    cancel_at_period_end    BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at              TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at              TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (org_id),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX org_subscriptions_current_period_end_idx ON org_subscriptions (current_period_end);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS org_statements;
+DROP TABLE IF EXISTS invoice_counters;
+
+COMMIT;
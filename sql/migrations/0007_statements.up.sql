@@ -0,0 +1,29 @@
+BEGIN;
+
+-- invoice numbers are allocated from the counter in the same transaction as the
+-- statement, so a rolled back statement never leaves a gap.
+CREATE TABLE invoice_counters (
+    year        INTEGER     NOT NULL,
+    last_no     BIGINT      NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (year)
+);
+
+CREATE TABLE org_statements (
+    id              UUID        DEFAULT gen_random_uuid(),
+    org_id          UUID        NOT NULL,
+    period_start    TIMESTAMPTZ NOT NULL,
+    period_end      TIMESTAMPTZ NOT NULL,
+    invoice_no      TEXT        NOT NULL,
+    opening_balance BIGINT      NOT NULL,
+    closing_balance BIGINT      NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (org_id, period_start),
+    UNIQUE (invoice_no),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+COMMIT;
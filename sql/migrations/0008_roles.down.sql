@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_access_rules;
+DROP TABLE IF EXISTS roles;
+
+COMMIT;
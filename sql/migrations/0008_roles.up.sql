@@ -0,0 +1,33 @@
+BEGIN;
+
+-- roles bundle access rules, they are declared in the seed fixtures
+CREATE TABLE roles (
+    id          UUID        DEFAULT gen_random_uuid(),
+    name        TEXT        NOT NULL,
+    description TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (name)
+);
+
+CREATE TABLE role_access_rules (
+    role_id     UUID NOT NULL,
+    rule_id     UUID NOT NULL,
+
+    PRIMARY KEY (role_id, rule_id),
+    FOREIGN KEY (role_id) REFERENCES roles (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (rule_id) REFERENCES access_rules (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE user_roles (
+    user_id     UUID NOT NULL,
+    role_id     UUID NOT NULL,
+
+    PRIMARY KEY (user_id, role_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (role_id) REFERENCES roles (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE recharge_orders DROP CONSTRAINT recharge_orders_user_id_fkey;
+ALTER TABLE recharge_orders ADD CONSTRAINT recharge_orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE;
+ALTER TABLE recharge_orders ALTER COLUMN user_id SET NOT NULL;
+
+DROP INDEX IF EXISTS orgs_deleted_at_idx;
+DROP INDEX IF EXISTS users_deleted_at_idx;
+
+DROP INDEX IF EXISTS orgs_name_key;
+ALTER TABLE orgs ADD CONSTRAINT orgs_name_key UNIQUE (name);
+
+DROP INDEX IF EXISTS users_name_key;
+DROP INDEX IF EXISTS users_phone_key;
+ALTER TABLE users ADD CONSTRAINT users_name_key UNIQUE (name);
+ALTER TABLE users ADD CONSTRAINT users_phone_key UNIQUE (phone);
+
+COMMIT;
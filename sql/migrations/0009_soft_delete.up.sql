@@ -0,0 +1,21 @@
+BEGIN;
+
+-- uniqueness only applies to rows that are not soft deleted, so a deleted
+-- phone or name can be registered again once the grace period is over
+ALTER TABLE users DROP CONSTRAINT users_phone_key;
+ALTER TABLE users DROP CONSTRAINT users_name_key;
+CREATE UNIQUE INDEX users_phone_key ON users (phone) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX users_name_key ON users (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE orgs DROP CONSTRAINT orgs_name_key;
+CREATE UNIQUE INDEX orgs_name_key ON orgs (name) WHERE deleted_at IS NULL;
+
+CREATE INDEX users_deleted_at_idx ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX orgs_deleted_at_idx ON orgs (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- recharge orders outlive the user who placed them
+ALTER TABLE recharge_orders ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE recharge_orders DROP CONSTRAINT recharge_orders_user_id_fkey;
+ALTER TABLE recharge_orders ADD CONSTRAINT recharge_orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+COMMIT;
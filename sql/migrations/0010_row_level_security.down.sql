@@ -0,0 +1,45 @@
+BEGIN;
+
+DROP POLICY IF EXISTS org_isolation ON org_statements;
+ALTER TABLE org_statements NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE org_statements DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON org_subscriptions;
+ALTER TABLE org_subscriptions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE org_subscriptions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON usage_daily;
+ALTER TABLE usage_daily NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE usage_daily DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON recharge_orders;
+ALTER TABLE recharge_orders NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE recharge_orders DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON ledger_entries;
+ALTER TABLE ledger_entries NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE ledger_entries DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON ledger_transactions;
+ALTER TABLE ledger_transactions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE ledger_transactions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON org_balances;
+ALTER TABLE org_balances NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE org_balances DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON team_access_rules;
+ALTER TABLE team_access_rules NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE team_access_rules DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON team_members;
+ALTER TABLE team_members NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE team_members DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON teams;
+ALTER TABLE teams NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE teams DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION IF EXISTS org_visible(UUID);
+
+COMMIT;
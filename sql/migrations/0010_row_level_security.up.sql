@@ -0,0 +1,55 @@
+BEGIN;
+
+-- rows of the org tables are only visible to the org set in app.current_org, or to
+-- every org when app.bypass_rls is on. Both are set on each connection when it is
+-- acquired from the pool. Note that superusers and roles with BYPASSRLS are never
+-- subject to the policies.
+CREATE FUNCTION org_visible(org UUID) RETURNS BOOLEAN AS $$
+    SELECT current_setting('app.bypass_rls', true) = 'on'
+        OR org = NULLIF(current_setting('app.current_org', true), '')::UUID;
+$$ LANGUAGE sql STABLE;
+
+ALTER TABLE teams ENABLE ROW LEVEL SECURITY;
+ALTER TABLE teams FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON teams USING (org_visible(org_id));
+
+-- team tables follow the visibility of their team
+ALTER TABLE team_members ENABLE ROW LEVEL SECURITY;
+ALTER TABLE team_members FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON team_members USING (team_id IN (SELECT id FROM teams));
+
+ALTER TABLE team_access_rules ENABLE ROW LEVEL SECURITY;
+ALTER TABLE team_access_rules FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON team_access_rules USING (team_id IN (SELECT id FROM teams));
+
+ALTER TABLE org_balances ENABLE ROW LEVEL SECURITY;
+ALTER TABLE org_balances FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON org_balances USING (org_visible(org_id));
+
+ALTER TABLE ledger_transactions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE ledger_transactions FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON ledger_transactions USING (org_visible(org_id));
+
+-- entries of the platform accounts have no org, they follow the visibility of their
+-- transaction so that the balance check sees all entries of the transaction
+ALTER TABLE ledger_entries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE ledger_entries FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON ledger_entries USING (transaction_id IN (SELECT id FROM ledger_transactions));
+
+ALTER TABLE recharge_orders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE recharge_orders FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON recharge_orders USING (org_visible(org_id));
+
+ALTER TABLE usage_daily ENABLE ROW LEVEL SECURITY;
+ALTER TABLE usage_daily FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON usage_daily USING (org_visible(org_id));
+
+ALTER TABLE org_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE org_subscriptions FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON org_subscriptions USING (org_visible(org_id));
+
+ALTER TABLE org_statements ENABLE ROW LEVEL SECURITY;
+ALTER TABLE org_statements FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON org_statements USING (org_visible(org_id));
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS org_subscriptions_bump_version ON org_subscriptions;
+ALTER TABLE org_subscriptions DROP COLUMN IF EXISTS version;
+DROP TRIGGER IF EXISTS teams_bump_version ON teams;
+ALTER TABLE teams DROP COLUMN IF EXISTS version;
+DROP TRIGGER IF EXISTS users_bump_version ON users;
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+DROP TRIGGER IF EXISTS orgs_bump_version ON orgs;
+ALTER TABLE orgs DROP COLUMN IF EXISTS version;
+DROP FUNCTION IF EXISTS bump_version();
+
+DROP TRIGGER IF EXISTS roles_set_updated_at ON roles;
+DROP TRIGGER IF EXISTS invoice_counters_set_updated_at ON invoice_counters;
+DROP TRIGGER IF EXISTS org_subscriptions_set_updated_at ON org_subscriptions;
+DROP TRIGGER IF EXISTS usage_daily_set_updated_at ON usage_daily;
+DROP TRIGGER IF EXISTS recharge_orders_set_updated_at ON recharge_orders;
+DROP TRIGGER IF EXISTS org_balances_set_updated_at ON org_balances;
+DROP TRIGGER IF EXISTS teams_set_updated_at ON teams;
+DROP TRIGGER IF EXISTS access_rules_set_updated_at ON access_rules;
+DROP TRIGGER IF EXISTS phone_code_set_updated_at ON phone_code;
+DROP TRIGGER IF EXISTS users_set_updated_at ON users;
+DROP TRIGGER IF EXISTS orgs_set_updated_at ON orgs;
+DROP FUNCTION IF EXISTS set_updated_at();
+
+COMMIT;
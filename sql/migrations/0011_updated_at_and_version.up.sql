@@ -0,0 +1,44 @@
+BEGIN;
+
+-- updated_at is maintained by the database, so no query can leave it stale
+CREATE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER orgs_set_updated_at BEFORE UPDATE ON orgs FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER users_set_updated_at BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER phone_code_set_updated_at BEFORE UPDATE ON phone_code FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER access_rules_set_updated_at BEFORE UPDATE ON access_rules FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER teams_set_updated_at BEFORE UPDATE ON teams FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER org_balances_set_updated_at BEFORE UPDATE ON org_balances FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER recharge_orders_set_updated_at BEFORE UPDATE ON recharge_orders FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER usage_daily_set_updated_at BEFORE UPDATE ON usage_daily FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER org_subscriptions_set_updated_at BEFORE UPDATE ON org_subscriptions FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER invoice_counters_set_updated_at BEFORE UPDATE ON invoice_counters FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER roles_set_updated_at BEFORE UPDATE ON roles FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- the version of the mutable entities is bumped on every update, updates carrying
+-- the version the client has read fail if the row has been modified since
+CREATE FUNCTION bump_version() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE orgs ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+CREATE TRIGGER orgs_bump_version BEFORE UPDATE ON orgs FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+ALTER TABLE users ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+CREATE TRIGGER users_bump_version BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+ALTER TABLE teams ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+CREATE TRIGGER teams_bump_version BEFORE UPDATE ON teams FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+ALTER TABLE org_subscriptions ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+CREATE TRIGGER org_subscriptions_bump_version BEFORE UPDATE ON org_subscriptions FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+-- domain events are written in the same transaction as the change they describe and
+-- delivered to the handlers by the dispatcher afterwards.
+CREATE TABLE outbox_events (
+    id              BIGSERIAL,
+    typ             VARCHAR(64) NOT NULL,
+    payload         JSONB       NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    available_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dispatched_at   TIMESTAMPTZ,
+    dead_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events (available_at, id) WHERE dispatched_at IS NULL AND dead_at IS NULL;
+
+-- wake up the dispatchers, the notification is only delivered when the transaction commits
+CREATE FUNCTION outbox_events_notify() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_events', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT ON outbox_events
+    FOR EACH STATEMENT EXECUTE FUNCTION outbox_events_notify();
+
+COMMIT;
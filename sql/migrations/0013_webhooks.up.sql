@@ -0,0 +1,54 @@
+BEGIN;
+
+CREATE TABLE webhook_endpoints (
+    id          UUID        DEFAULT gen_random_uuid(),
+    org_id      UUID        NOT NULL,
+    url         TEXT        NOT NULL,
+    -- deliveries are signed with the secret, the receivers verify them with it
+    secret      TEXT        NOT NULL,
+    events      TEXT[]      NOT NULL,
+    enabled     BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX webhook_endpoints_org_id_idx ON webhook_endpoints (org_id);
+
+CREATE TABLE webhook_deliveries (
+    id              UUID        DEFAULT gen_random_uuid(),
+    endpoint_id     UUID        NOT NULL,
+    event           VARCHAR(64) NOT NULL,
+    payload         JSONB       NOT NULL,
+    -- the outbox event the delivery is enqueued for, so that redelivered events are
+    -- not delivered twice
+    outbox_event_id BIGINT,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    response_code   INTEGER,
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (endpoint_id, outbox_event_id),
+    FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_endpoint_id_created_at_idx ON webhook_deliveries (endpoint_id, created_at, id);
+
+CREATE TRIGGER webhook_endpoints_set_updated_at BEFORE UPDATE ON webhook_endpoints FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER webhook_deliveries_set_updated_at BEFORE UPDATE ON webhook_deliveries FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+ALTER TABLE webhook_endpoints ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_endpoints FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON webhook_endpoints USING (org_visible(org_id));
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON webhook_deliveries USING (endpoint_id IN (SELECT id FROM webhook_endpoints));
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- background jobs, claimed by the workers with SELECT ... FOR UPDATE SKIP LOCKED so
+-- that each job is run by one worker at a time.
+CREATE TABLE jobs (
+    id              BIGSERIAL,
+    kind            VARCHAR(64) NOT NULL,
+    args            JSONB       NOT NULL,
+    -- at most one pending or running job of the kind has the key
+    unique_key      TEXT,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    max_attempts    INTEGER     NOT NULL,
+    last_error      TEXT,
+    run_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_at       TIMESTAMPTZ,
+    finished_at     TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX jobs_unique_key_idx ON jobs (kind, unique_key) WHERE unique_key IS NOT NULL AND status IN ('pending', 'running');
+CREATE INDEX jobs_pending_idx ON jobs (run_at, id) WHERE status = 'pending';
+CREATE INDEX jobs_running_idx ON jobs (locked_at) WHERE status = 'running';
+
+CREATE TRIGGER jobs_set_updated_at BEFORE UPDATE ON jobs FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
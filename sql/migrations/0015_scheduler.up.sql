@@ -0,0 +1,22 @@
+BEGIN;
+
+-- history of the scheduled tasks, at most one run of a task is recorded per scheduled
+-- time so that the replicas firing the same tick run it once.
+CREATE TABLE scheduled_task_runs (
+    id              UUID        NOT NULL DEFAULT gen_random_uuid(),
+    task            VARCHAR(64) NOT NULL,
+    scheduled_at    TIMESTAMPTZ NOT NULL,
+    -- succeeded or failed
+    status          VARCHAR(16) NOT NULL,
+    error           TEXT,
+    started_at      TIMESTAMPTZ NOT NULL,
+    finished_at     TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (task, scheduled_at)
+);
+
+CREATE INDEX scheduled_task_runs_created_at_idx ON scheduled_task_runs (created_at, id);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+-- the revoked grants are not restored
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- the migrations run as the app role, which is subject to the row-level security of
+-- the team tables, bypass it for this transaction to see the grants of all orgs
+SELECT set_config('app.bypass_rls', 'on', true);
+
+-- the platform rules are granted by the platform only, revoke those granted to teams
+-- by the org owners.
+DELETE FROM team_access_rules
+WHERE rule_id IN (SELECT id FROM access_rules WHERE name IN ('worker', 'admin', 'premium'));
+
+COMMIT;
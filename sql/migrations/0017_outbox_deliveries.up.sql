@@ -0,0 +1,20 @@
+BEGIN;
+
+-- the delivery of an event to each of its handlers, so that a failing handler is
+-- retried and dead-lettered on its own without running the others again.
+CREATE TABLE outbox_deliveries (
+    event_id        BIGINT      NOT NULL REFERENCES outbox_events (id) ON DELETE CASCADE,
+    handler         VARCHAR(64) NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    dead_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (event_id, handler)
+);
+
+CREATE TRIGGER outbox_deliveries_set_updated_at BEFORE UPDATE ON outbox_deliveries FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP POLICY IF EXISTS org_isolation ON users;
+ALTER TABLE users NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE users DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS org_isolation ON orgs;
+ALTER TABLE orgs NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE orgs DISABLE ROW LEVEL SECURITY;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+-- the users and the orgs are isolated like the other org tables, so that a query
+-- missing its org filter cannot read the phones and the password hashes of the other
+-- orgs. The lookups which are global by design, e.g. signing in by phone, bypass it.
+ALTER TABLE orgs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE orgs FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON orgs USING (org_visible(id));
+
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users FORCE ROW LEVEL SECURITY;
+CREATE POLICY org_isolation ON users USING (org_visible(org_id));
+
+COMMIT;
@@ -0,0 +1,8 @@
+// Package migrations embeds the SQL migrations, so the binary can migrate the
+// database without shipping the migration files.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	names, err := fs.Glob(FS, "*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+
+	ups := map[string]bool{}
+	downs := map[string]bool{}
+	for _, name := range names {
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			ups[strings.TrimSuffix(name, ".up.sql")] = true
+		case strings.HasSuffix(name, ".down.sql"):
+			downs[strings.TrimSuffix(name, ".down.sql")] = true
+		default:
+			t.Errorf("%s is neither an up nor a down migration", name)
+		}
+	}
+	// every migration can be rolled back
+	assert.Equal(t, ups, downs)
+}
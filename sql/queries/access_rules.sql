@@ -11,8 +11,11 @@ SELECT * FROM access_rules WHERE name = $1;
 
 -- name: AddUserAccessRule :exec
 INSERT INTO user_access_rules (user_id, rule_id) VALUES ((
-    SELECT id FROM users WHERE name = $1
+    SELECT id FROM users WHERE name = $1 AND deleted_at IS NULL
 ), $2) ON CONFLICT DO NOTHING;
 
 -- name: RemoveUserAccessRule :exec
 DELETE FROM user_access_rules WHERE user_id = $1 AND rule_id = $2;
+
+-- name: CreateAccessRuleIfNotExists :exec
+INSERT INTO access_rules (name) VALUES ($1) ON CONFLICT (name) DO NOTHING;
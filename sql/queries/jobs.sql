@@ -0,0 +1,39 @@
+-- name: InsertJob :one
+INSERT INTO jobs (
+    kind,
+    args,
+    unique_key,
+    max_attempts,
+    run_at
+) VALUES ($1, $2, $3, $4, COALESCE(sqlc.narg(run_at), CURRENT_TIMESTAMP))
+ON CONFLICT (kind, unique_key) WHERE unique_key IS NOT NULL AND status IN ('pending', 'running') DO NOTHING
+RETURNING id;
+
+-- name: ClaimNextJob :one
+UPDATE jobs SET status = 'running', attempts = attempts + 1, locked_at = sqlc.arg(now)::TIMESTAMPTZ
+WHERE id = (
+    SELECT id FROM jobs
+    WHERE status = 'pending' AND run_at <= sqlc.arg(now)::TIMESTAMPTZ
+    ORDER BY run_at, id LIMIT 1 FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteJob :execrows
+UPDATE jobs SET status = 'succeeded', locked_at = NULL, finished_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'running' AND locked_at = sqlc.arg(claimed_at)::TIMESTAMPTZ;
+
+-- name: RetryJob :execrows
+UPDATE jobs SET status = 'pending', locked_at = NULL, last_error = $2, run_at = $3
+WHERE id = $1 AND status = 'running' AND locked_at = sqlc.arg(claimed_at)::TIMESTAMPTZ;
+
+-- name: FailJob :execrows
+UPDATE jobs SET status = 'failed', locked_at = NULL, last_error = $2, finished_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'running' AND locked_at = sqlc.arg(claimed_at)::TIMESTAMPTZ;
+
+-- name: RescueStuckJobs :execrows
+UPDATE jobs SET
+    status = CASE WHEN attempts >= max_attempts THEN 'failed' ELSE 'pending' END,
+    finished_at = CASE WHEN attempts >= max_attempts THEN CURRENT_TIMESTAMP END,
+    locked_at = NULL,
+    last_error = 'abandoned by the worker'
+WHERE status = 'running' AND locked_at < sqlc.arg(locked_before)::TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+-- name: InitOrgBalance :exec
+INSERT INTO org_balances (org_id) VALUES ($1) ON CONFLICT DO NOTHING;
+
+-- name: GetOrgBalance :one
+SELECT balance FROM org_balances WHERE org_id = $1;
+
+-- name: GetOrgBalanceForUpdate :one
+SELECT balance FROM org_balances WHERE org_id = $1 FOR UPDATE;
+
+-- name: UpdateOrgBalance :exec
+UPDATE org_balances SET balance = $2 WHERE org_id = $1;
+
+-- name: CreateLedgerTransaction :one
+INSERT INTO ledger_transactions (
+    org_id,
+    typ,
+    amount,
+    description
+) VALUES ($1, $2, $3, $4) RETURNING * ;
+
+-- name: CreateLedgerEntry :exec
+INSERT INTO ledger_entries (
+    transaction_id,
+    account,
+    org_id,
+    amount
+) VALUES ($1, $2, $3, $4);
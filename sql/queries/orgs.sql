@@ -5,3 +5,14 @@ INSERT INTO orgs (
 
 -- name: UpdateOrgOwnerID :exec
 UPDATE orgs SET owner_id = $1 WHERE id = $2;
+
+-- name: SoftDeleteOrg :exec
+UPDATE orgs SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: PurgeDeletedOrgs :execrows
+DELETE FROM orgs
+WHERE deleted_at < sqlc.arg(deleted_before)::TIMESTAMPTZ
+    AND NOT EXISTS (SELECT 1 FROM users WHERE users.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM ledger_transactions WHERE ledger_transactions.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM recharge_orders WHERE recharge_orders.org_id = orgs.id)
+    AND NOT EXISTS (SELECT 1 FROM org_statements WHERE org_statements.org_id = orgs.id);
@@ -0,0 +1,32 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (typ, payload) VALUES ($1, $2);
+
+-- name: ClaimNextOutboxEvent :one
+UPDATE outbox_events SET attempts = attempts + 1, available_at = sqlc.arg(locked_until)::TIMESTAMPTZ
+WHERE id = (
+    SELECT id FROM outbox_events
+    WHERE dispatched_at IS NULL AND dead_at IS NULL AND available_at <= sqlc.arg(now)::TIMESTAMPTZ
+    ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxEventDispatched :exec
+UPDATE outbox_events SET dispatched_at = CURRENT_TIMESTAMP WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events SET last_error = $2, available_at = $3 WHERE id = $1;
+
+-- name: MarkOutboxEventDead :exec
+UPDATE outbox_events SET last_error = $2, dead_at = CURRENT_TIMESTAMP WHERE id = $1;
+
+-- name: ListOutboxDeliveries :many
+SELECT * FROM outbox_deliveries WHERE event_id = $1;
+
+-- name: UpsertOutboxDelivery :exec
+INSERT INTO outbox_deliveries (event_id, handler, attempts, last_error, delivered_at, dead_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (event_id, handler) DO UPDATE SET
+    attempts = EXCLUDED.attempts,
+    last_error = EXCLUDED.last_error,
+    delivered_at = EXCLUDED.delivered_at,
+    dead_at = EXCLUDED.dead_at;
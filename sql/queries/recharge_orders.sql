@@ -0,0 +1,25 @@
+-- name: CreateRechargeOrder :one
+INSERT INTO recharge_orders (
+    org_id,
+    user_id,
+    provider,
+    amount,
+    expired_at
+) VALUES ($1, $2, $3, $4, $5) RETURNING * ;
+
+-- name: GetRechargeOrder :one
+SELECT * FROM recharge_orders WHERE id = $1 AND org_id = $2;
+
+-- name: GetRechargeOrderForUpdate :one
+SELECT * FROM recharge_orders WHERE id = $1 FOR UPDATE;
+
+-- name: UpdatePendingRechargeOrderStatus :exec
+UPDATE recharge_orders SET status = $2 WHERE id = $1 AND status = 'pending';
+
+-- name: MarkRechargeOrderPaid :exec
+UPDATE recharge_orders SET
+    status = 'paid',
+    provider_trade_id = $2,
+    ledger_transaction_id = $3,
+    paid_at = CURRENT_TIMESTAMP
+WHERE id = $1;
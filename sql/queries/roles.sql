@@ -0,0 +1,19 @@
+-- name: UpsertRole :one
+INSERT INTO roles (name, description) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description
+RETURNING *;
+
+-- name: AddRoleAccessRules :exec
+INSERT INTO role_access_rules (role_id, rule_id)
+SELECT sqlc.arg(role_id)::UUID, id FROM access_rules WHERE name = ANY(sqlc.arg(rules)::TEXT[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveRoleAccessRulesExcept :exec
+DELETE FROM role_access_rules
+WHERE role_id = sqlc.arg(role_id)
+    AND rule_id NOT IN (SELECT id FROM access_rules WHERE name = ANY(sqlc.arg(rules)::TEXT[]));
+
+-- name: AddUserRole :exec
+INSERT INTO user_roles (user_id, role_id)
+SELECT sqlc.arg(user_id)::UUID, id FROM roles WHERE name = sqlc.arg(role)
+ON CONFLICT DO NOTHING;
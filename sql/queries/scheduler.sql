@@ -0,0 +1,32 @@
+-- name: IsScheduledTaskRun :one
+SELECT EXISTS (
+    SELECT 1 FROM scheduled_task_runs WHERE task = $1 AND scheduled_at = $2
+) AS exist;
+
+-- name: CreateScheduledTaskRun :one
+INSERT INTO scheduled_task_runs (
+    task,
+    scheduled_at,
+    status,
+    error,
+    started_at,
+    finished_at
+) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+
+-- name: PurgeScheduledTaskRuns :execrows
+DELETE FROM scheduled_task_runs WHERE task = $1 AND created_at < sqlc.arg(created_before)::TIMESTAMPTZ;
+
+-- name: ListScheduledTaskRuns :many
+SELECT * FROM scheduled_task_runs
+WHERE (sqlc.narg(task)::TEXT IS NULL OR task = sqlc.narg(task))
+    AND (
+        sqlc.narg(cursor_created_at)::TIMESTAMPTZ IS NULL
+        OR (NOT sqlc.arg(descending)::BOOLEAN AND (created_at, id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+        OR (sqlc.arg(descending)::BOOLEAN AND (created_at, id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN created_at END DESC,
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN id END DESC,
+    created_at,
+    id
+LIMIT sqlc.arg(row_limit)::INTEGER;
@@ -0,0 +1,38 @@
+-- name: NextInvoiceNo :one
+INSERT INTO invoice_counters (year, last_no) VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE SET last_no = invoice_counters.last_no + 1
+RETURNING last_no;
+
+-- name: CreateOrgStatement :one
+INSERT INTO org_statements (
+    org_id,
+    period_start,
+    period_end,
+    invoice_no,
+    opening_balance,
+    closing_balance
+) VALUES ($1, $2, $3, $4, $5, $6) RETURNING * ;
+
+-- name: GetOrgStatement :one
+SELECT * FROM org_statements WHERE org_id = $1 AND period_start = $2;
+
+-- name: ListOrgStatements :many
+SELECT * FROM org_statements WHERE org_id = $1 ORDER BY period_start DESC;
+
+-- name: GetOrgLedgerBalanceBefore :one
+SELECT COALESCE(SUM(amount), 0)::BIGINT AS balance FROM ledger_entries
+WHERE account = 'org' AND org_id = $1 AND created_at < sqlc.arg(before);
+
+-- name: GetOrgLedgerSummary :many
+SELECT
+    ledger_transactions.typ,
+    COUNT(*)::BIGINT AS count,
+    SUM(ledger_entries.amount)::BIGINT AS amount
+FROM ledger_entries
+JOIN ledger_transactions ON ledger_transactions.id = ledger_entries.transaction_id
+WHERE ledger_entries.account = 'org'
+    AND ledger_entries.org_id = $1
+    AND ledger_entries.created_at >= sqlc.arg(period_start)
+    AND ledger_entries.created_at < sqlc.arg(period_end)
+GROUP BY ledger_transactions.typ
+ORDER BY ledger_transactions.typ;
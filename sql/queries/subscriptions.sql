@@ -0,0 +1,38 @@
+-- name: GetOrgSubscription :one
+SELECT * FROM org_subscriptions WHERE org_id = $1;
+
+-- name: GetOrgSubscriptionForUpdate :one
+SELECT * FROM org_subscriptions WHERE org_id = $1 FOR UPDATE;
+
+-- name: UpsertOrgSubscription :one
+INSERT INTO org_subscriptions (
+    org_id,
+    plan,
+    current_period_start,
+    current_period_end
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (org_id) DO UPDATE SET
+    plan = EXCLUDED.plan,
+    current_period_start = EXCLUDED.current_period_start,
+    current_period_end = EXCLUDED.current_period_end,
+    cancel_at_period_end = FALSE
+RETURNING * ;
+
+-- name: GetDueOrgSubscriptionForUpdate :one
+SELECT * FROM org_subscriptions
+WHERE current_period_end <= $1 AND NOT (org_id = ANY(COALESCE(sqlc.arg(excluded)::UUID[], '{}')))
+ORDER BY current_period_end LIMIT 1 FOR UPDATE SKIP LOCKED;
+
+-- name: RenewOrgSubscription :exec
+UPDATE org_subscriptions SET current_period_start = $2, current_period_end = $3 WHERE org_id = $1;
+
+-- name: ResumeOrgSubscription :one
+UPDATE org_subscriptions SET cancel_at_period_end = FALSE WHERE org_id = $1
+RETURNING *;
+
+-- name: CancelOrgSubscription :execrows
+UPDATE org_subscriptions SET cancel_at_period_end = TRUE
+WHERE org_id = $1 AND (sqlc.narg(version)::INTEGER IS NULL OR version = sqlc.narg(version));
+
+-- name: DeleteOrgSubscription :exec
+DELETE FROM org_subscriptions WHERE org_id = $1;
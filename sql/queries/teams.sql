@@ -0,0 +1,52 @@
+-- name: CreateTeam :one
+INSERT INTO teams (
+    org_id,
+    name
+) VALUES ($1, $2) RETURNING * ;
+
+-- name: IsTeamNameExist :one
+SELECT EXISTS (SELECT 1 FROM teams WHERE org_id = $1 AND name = $2) AS exist;
+
+-- name: GetTeam :one
+SELECT * FROM teams WHERE id = $1 AND org_id = $2;
+
+-- name: ListOrgTeams :many
+SELECT * FROM teams WHERE org_id = $1 ORDER BY created_at;
+
+-- name: UpdateTeamName :one
+UPDATE teams SET name = $3
+WHERE id = $1 AND org_id = $2 AND (sqlc.narg(version)::INTEGER IS NULL OR version = sqlc.narg(version))
+RETURNING * ;
+
+-- name: DeleteTeam :execrows
+DELETE FROM teams
+WHERE id = $1 AND org_id = $2 AND (sqlc.narg(version)::INTEGER IS NULL OR version = sqlc.narg(version));
+
+-- name: AddTeamMember :exec
+INSERT INTO team_members (team_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: RemoveTeamMember :exec
+DELETE FROM team_members WHERE team_id = $1 AND user_id = $2;
+
+-- name: GetTeamMembers :many
+SELECT
+    users.id,
+    users.name,
+    users.phone
+FROM users
+JOIN team_members ON team_members.user_id = users.id
+WHERE team_members.team_id = $1 AND users.deleted_at IS NULL
+ORDER BY team_members.created_at;
+
+-- name: AddTeamAccessRule :exec
+INSERT INTO team_access_rules (team_id, rule_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: RemoveTeamAccessRule :exec
+DELETE FROM team_access_rules WHERE team_id = $1 AND rule_id = $2;
+
+-- name: GetTeamAccessRuleNames :many
+SELECT
+    access_rules.name
+FROM access_rules
+JOIN team_access_rules ON team_access_rules.rule_id = access_rules.id
+WHERE team_access_rules.team_id = $1;
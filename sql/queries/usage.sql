@@ -0,0 +1,21 @@
+-- name: IncreaseUsage :exec
+INSERT INTO usage_daily (org_id, metric, day, count) VALUES ($1, $2, $3, $4)
+ON CONFLICT (org_id, metric, day) DO UPDATE SET count = usage_daily.count + EXCLUDED.count;
+
+-- name: InitUsage :exec
+INSERT INTO usage_daily (org_id, metric, day) VALUES ($1, $2, $3)
+ON CONFLICT (org_id, metric, day) DO NOTHING;
+
+-- name: ConsumeUsage :execrows
+UPDATE usage_daily SET count = usage_daily.count + 1
+WHERE usage_daily.org_id = $1 AND usage_daily.metric = $2 AND usage_daily.day = sqlc.arg(day)
+    AND usage_daily.count + (
+        SELECT COALESCE(SUM(former.count), 0) FROM usage_daily AS former
+        WHERE former.org_id = $1 AND former.metric = $2
+            AND former.day >= sqlc.arg(since) AND former.day < sqlc.arg(day)
+    ) < sqlc.arg(quota)::BIGINT;
+
+-- name: GetOrgUsage :many
+SELECT metric, SUM(count)::BIGINT AS count FROM usage_daily
+WHERE org_id = $1 AND day >= sqlc.arg(since)
+GROUP BY metric;
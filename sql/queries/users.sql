@@ -1,8 +1,12 @@
 -- name: IsUsernameExist :one
-SELECT EXISTS (SELECT 1 FROM users WHERE name = $1) AS exist;
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE name = $1 AND (deleted_at IS NULL OR deleted_at > sqlc.arg(deleted_after)::TIMESTAMPTZ)
+) AS exist;
 
 -- name: IsPhoneExist :one
-SELECT EXISTS (SELECT 1 FROM users WHERE phone = $1) AS exist;
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE phone = $1 AND (deleted_at IS NULL OR deleted_at > sqlc.arg(deleted_after)::TIMESTAMPTZ)
+) AS exist;
 
 -- name: CreateUser :one
 INSERT INTO users (
@@ -18,7 +22,21 @@ SELECT
     access_rules.name 
 FROM access_rules
 JOIN user_access_rules ON user_access_rules.rule_id = access_rules.id
-WHERE user_access_rules.user_id = $1;
+WHERE user_access_rules.user_id = $1
+UNION
+SELECT
+    access_rules.name
+FROM access_rules
+JOIN team_access_rules ON team_access_rules.rule_id = access_rules.id
+JOIN team_members ON team_members.team_id = team_access_rules.team_id
+WHERE team_members.user_id = $1
+UNION
+SELECT
+    access_rules.name
+FROM access_rules
+JOIN role_access_rules ON role_access_rules.rule_id = access_rules.id
+JOIN user_roles ON user_roles.role_id = role_access_rules.role_id
+WHERE user_roles.user_id = $1;
 
 -- name: UpsertPhoneCode :one
 INSERT INTO phone_code (
@@ -28,7 +46,7 @@ INSERT INTO phone_code (
     expired_at,
     updated_at
 ) VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP) 
-ON CONFLICT (phone, typ) DO UPDATE SET code = $3, expired_at = $4, used = FALSE, updated_at = CURRENT_TIMESTAMP
+ON CONFLICT (phone, typ) DO UPDATE SET code = $3, expired_at = $4, used = FALSE
 RETURNING * ;
 
 -- name: GetPhoneCode :one
@@ -37,11 +55,52 @@ SELECT * FROM phone_code WHERE phone = $1 AND typ = $2;
 -- name: MarkPhoneCodeUsed :exec
 UPDATE phone_code SET used = TRUE WHERE phone = $1 AND typ = $2;
 
+-- name: PurgeExpiredPhoneCodes :execrows
+DELETE FROM phone_code WHERE expired_at < sqlc.arg(expired_before)::TIMESTAMPTZ;
+
 -- name: GetUser :one
-SELECT * FROM users WHERE phone = $1 OR name = $1;
+SELECT * FROM users WHERE (phone = $1 OR name = $1) AND deleted_at IS NULL;
+
+-- name: GetUserByID :one
+SELECT * FROM users WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: IsUserActive :one
+SELECT EXISTS (
+    SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL
+) AS active;
 
 -- name: UpdateUserPasswordByPhone :exec
-UPDATE users SET password_hash = $2, password_salt = $3 WHERE phone = $1;
+UPDATE users SET password_hash = $2, password_salt = $3 WHERE phone = $1 AND deleted_at IS NULL;
 
 -- name: GetOrgInfoByOrgId :one
-SELECT * FROM orgs WHERE id = $1;
+SELECT * FROM orgs WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: CountOtherOrgMembers :one
+SELECT COUNT(*) FROM users WHERE org_id = $1 AND id <> $2 AND deleted_at IS NULL;
+
+-- name: SoftDeleteUser :exec
+UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: PurgeDeletedUsers :execrows
+DELETE FROM users WHERE deleted_at < sqlc.arg(deleted_before)::TIMESTAMPTZ;
+
+-- name: ListOrgMembers :many
+SELECT
+    id,
+    name,
+    phone,
+    created_at
+FROM users
+WHERE org_id = $1 AND deleted_at IS NULL
+    AND (sqlc.narg(keyword)::TEXT IS NULL OR name LIKE '%' || sqlc.narg(keyword) || '%' OR phone LIKE '%' || sqlc.narg(keyword) || '%')
+    AND (
+        sqlc.narg(cursor_created_at)::TIMESTAMPTZ IS NULL
+        OR (NOT sqlc.arg(descending)::BOOLEAN AND (created_at, id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+        OR (sqlc.arg(descending)::BOOLEAN AND (created_at, id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN created_at END DESC,
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN id END DESC,
+    created_at,
+    id
+LIMIT sqlc.arg(row_limit)::INTEGER;
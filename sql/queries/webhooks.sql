@@ -0,0 +1,65 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (
+    org_id,
+    url,
+    secret,
+    events
+) VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints WHERE id = $1 AND org_id = $2;
+
+-- name: GetWebhookEndpointByID :one
+SELECT * FROM webhook_endpoints WHERE id = $1;
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints WHERE org_id = $1 ORDER BY created_at;
+
+-- name: ListWebhookEndpointsByEvent :many
+SELECT * FROM webhook_endpoints WHERE org_id = $1 AND enabled AND sqlc.arg(event)::TEXT = ANY(events) ORDER BY created_at;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints SET url = $3, events = $4, enabled = $5
+WHERE id = $1 AND org_id = $2
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints WHERE id = $1 AND org_id = $2;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    endpoint_id,
+    event,
+    payload,
+    outbox_event_id
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (endpoint_id, outbox_event_id) DO NOTHING
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = $1;
+
+-- name: UpdateWebhookDeliveryResult :one
+UPDATE webhook_deliveries SET
+    status = $2,
+    attempts = attempts + 1,
+    response_code = $3,
+    last_error = $4,
+    delivered_at = CASE WHEN $2 = 'succeeded' THEN CURRENT_TIMESTAMP ELSE delivered_at END
+WHERE id = $1
+RETURNING *;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = $1
+    AND (
+        sqlc.narg(cursor_created_at)::TIMESTAMPTZ IS NULL
+        OR (NOT sqlc.arg(descending)::BOOLEAN AND (created_at, id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+        OR (sqlc.arg(descending)::BOOLEAN AND (created_at, id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::UUID))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN created_at END DESC,
+    CASE WHEN sqlc.arg(descending)::BOOLEAN THEN id END DESC,
+    created_at,
+    id
+LIMIT sqlc.arg(row_limit)::INTEGER;
@@ -0,0 +1,8 @@
+// Package seeds embeds the seed fixtures, each directory is the fixture set of an
+// environment.
+package seeds
+
+import "embed"
+
+//go:embed dev prod
+var FS embed.FS
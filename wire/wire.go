@@ -5,24 +5,79 @@ package wire
 
 import (
 	"github.com/google/wire"
+	"github.com/xich-dev/go-starter/pkg/apps"
 	"github.com/xich-dev/go-starter/pkg/apps/server"
+	"github.com/xich-dev/go-starter/pkg/apps/worker"
+	"github.com/xich-dev/go-starter/pkg/cloud/dingtalk"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
 	"github.com/xich-dev/go-starter/pkg/cloud/sms"
 	"github.com/xich-dev/go-starter/pkg/config"
 	"github.com/xich-dev/go-starter/pkg/controller"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
 	"github.com/xich-dev/go-starter/pkg/middleware"
 	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
 	"github.com/xich-dev/go-starter/pkg/service"
+	"github.com/xich-dev/go-starter/pkg/webhook"
 )
 
-func InitializeServer() (*server.Server, error) {
+// serviceSet provides the service with the config, the model and the providers shared
+// by the apps.
+var serviceSet = wire.NewSet(
+	config.NewConfig,
+	service.NewService,
+	model.NewModel,
+	sms.NewSMSManager,
+	payment.NewPaymentProvider,
+	dingtalk.NewDingTalkClient,
+	webhook.NewSender,
+	health.NewRegistry,
+)
+
+var serverSet = wire.NewSet(
+	controller.NewController,
+	middleware.NewMiddleware,
+	server.NewServer,
+)
+
+var workerSet = wire.NewSet(
+	outbox.NewDispatcher,
+	jobs.NewWorker,
+	scheduler.NewScheduler,
+	worker.NewWorker,
+)
+
+func InitializeServer() (*server.Server, func(), error) {
+	wire.Build(
+		serviceSet,
+		serverSet,
+	)
+	return nil, nil, nil
+}
+
+func InitializeWorker() (*worker.Worker, func(), error) {
+	wire.Build(
+		serviceSet,
+		workerSet,
+	)
+	return nil, nil, nil
+}
+
+func InitializeAll() (*apps.All, func(), error) {
+	wire.Build(
+		serviceSet,
+		serverSet,
+		workerSet,
+		apps.NewAll,
+	)
+	return nil, nil, nil
+}
+
+func InitializeService() (service.ServiceInterface, func(), error) {
 	wire.Build(
-		config.NewConfig,
-		service.NewService,
-		controller.NewController,
-		model.NewModel,
-		server.NewServer,
-		middleware.NewMiddleware,
-		sms.NewSMSManager,
+		serviceSet,
 	)
-	return nil, nil
+	return nil, nil, nil
 }
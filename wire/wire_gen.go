@@ -7,33 +7,175 @@
 package wire
 
 import (
+	"github.com/google/wire"
+	"github.com/xich-dev/go-starter/pkg/apps"
 	"github.com/xich-dev/go-starter/pkg/apps/server"
+	"github.com/xich-dev/go-starter/pkg/apps/worker"
+	"github.com/xich-dev/go-starter/pkg/cloud/dingtalk"
+	"github.com/xich-dev/go-starter/pkg/cloud/payment"
 	"github.com/xich-dev/go-starter/pkg/cloud/sms"
 	"github.com/xich-dev/go-starter/pkg/config"
 	"github.com/xich-dev/go-starter/pkg/controller"
+	"github.com/xich-dev/go-starter/pkg/health"
+	"github.com/xich-dev/go-starter/pkg/jobs"
 	"github.com/xich-dev/go-starter/pkg/middleware"
 	"github.com/xich-dev/go-starter/pkg/model"
+	"github.com/xich-dev/go-starter/pkg/outbox"
+	"github.com/xich-dev/go-starter/pkg/scheduler"
 	"github.com/xich-dev/go-starter/pkg/service"
+	"github.com/xich-dev/go-starter/pkg/webhook"
 )
 
 // Injectors from wire.go:
 
-func InitializeServer() (*server.Server, error) {
+func InitializeServer() (*server.Server, func(), error) {
 	configConfig, err := config.NewConfig()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	modelInterface, err := model.NewModel(configConfig)
+	modelInterface, cleanup, err := model.NewModel(configConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	smsManagerInterface := sms.NewSMSManager(configConfig)
-	serviceInterface := service.NewService(configConfig, modelInterface, smsManagerInterface)
+	paymentProviderInterface, err := payment.NewPaymentProvider(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dingTalkClientInterface, err := dingtalk.NewDingTalkClient(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	senderInterface := webhook.NewSender(configConfig)
+	serviceInterface := service.NewService(configConfig, modelInterface, smsManagerInterface, paymentProviderInterface, dingTalkClientInterface, senderInterface)
+	middlewareMiddleware, err := middleware.NewMiddleware(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	controllerController := controller.NewController(serviceInterface, middlewareMiddleware)
+	registry := health.NewRegistry()
+	serverServer := server.NewServer(configConfig, controllerController, middlewareMiddleware, registry)
+	return serverServer, func() {
+		cleanup()
+	}, nil
+}
+
+func InitializeWorker() (*worker.Worker, func(), error) {
+	configConfig, err := config.NewConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	modelInterface, cleanup, err := model.NewModel(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	smsManagerInterface := sms.NewSMSManager(configConfig)
+	paymentProviderInterface, err := payment.NewPaymentProvider(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dingTalkClientInterface, err := dingtalk.NewDingTalkClient(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	senderInterface := webhook.NewSender(configConfig)
+	serviceInterface := service.NewService(configConfig, modelInterface, smsManagerInterface, paymentProviderInterface, dingTalkClientInterface, senderInterface)
+	dispatcher := outbox.NewDispatcher(modelInterface)
+	jobsWorker := jobs.NewWorker(configConfig, modelInterface)
+	schedulerScheduler, err := scheduler.NewScheduler(configConfig, modelInterface)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	registry := health.NewRegistry()
+	workerWorker := worker.NewWorker(configConfig, serviceInterface, dispatcher, jobsWorker, schedulerScheduler, registry)
+	return workerWorker, func() {
+		cleanup()
+	}, nil
+}
+
+func InitializeAll() (*apps.All, func(), error) {
+	configConfig, err := config.NewConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	modelInterface, cleanup, err := model.NewModel(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	smsManagerInterface := sms.NewSMSManager(configConfig)
+	paymentProviderInterface, err := payment.NewPaymentProvider(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dingTalkClientInterface, err := dingtalk.NewDingTalkClient(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	senderInterface := webhook.NewSender(configConfig)
+	serviceInterface := service.NewService(configConfig, modelInterface, smsManagerInterface, paymentProviderInterface, dingTalkClientInterface, senderInterface)
 	middlewareMiddleware, err := middleware.NewMiddleware(configConfig)
 	if err != nil {
-		return nil, err
+		cleanup()
+		return nil, nil, err
 	}
 	controllerController := controller.NewController(serviceInterface, middlewareMiddleware)
-	serverServer := server.NewServer(configConfig, controllerController, middlewareMiddleware)
-	return serverServer, nil
+	registry := health.NewRegistry()
+	serverServer := server.NewServer(configConfig, controllerController, middlewareMiddleware, registry)
+	dispatcher := outbox.NewDispatcher(modelInterface)
+	jobsWorker := jobs.NewWorker(configConfig, modelInterface)
+	schedulerScheduler, err := scheduler.NewScheduler(configConfig, modelInterface)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	workerWorker := worker.NewWorker(configConfig, serviceInterface, dispatcher, jobsWorker, schedulerScheduler, registry)
+	all := apps.NewAll(serverServer, workerWorker)
+	return all, func() {
+		cleanup()
+	}, nil
+}
+
+func InitializeService() (service.ServiceInterface, func(), error) {
+	configConfig, err := config.NewConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	modelInterface, cleanup, err := model.NewModel(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	smsManagerInterface := sms.NewSMSManager(configConfig)
+	paymentProviderInterface, err := payment.NewPaymentProvider(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dingTalkClientInterface, err := dingtalk.NewDingTalkClient(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	senderInterface := webhook.NewSender(configConfig)
+	serviceInterface := service.NewService(configConfig, modelInterface, smsManagerInterface, paymentProviderInterface, dingTalkClientInterface, senderInterface)
+	return serviceInterface, func() {
+		cleanup()
+	}, nil
 }
+
+// wire.go:
+
+// serviceSet provides the service with the config, the model and the providers shared
+// by the apps.
+var serviceSet = wire.NewSet(config.NewConfig, service.NewService, model.NewModel, sms.NewSMSManager, payment.NewPaymentProvider, dingtalk.NewDingTalkClient, webhook.NewSender, health.NewRegistry)
+
+var serverSet = wire.NewSet(controller.NewController, middleware.NewMiddleware, server.NewServer)
+
+var workerSet = wire.NewSet(outbox.NewDispatcher, jobs.NewWorker, scheduler.NewScheduler, worker.NewWorker)